@@ -149,6 +149,17 @@ func clean(ctx context.Context, client *polaris.Client) error {
 				awsAccount.NativeID, testAcc.AccountID)
 		}
 
+		// Remove exocompute configs left behind by failed test runs.
+		exoCfgs, err := awsClient.ExocomputeConfigs(ctx, aws.CloudAccountID(awsAccount.ID))
+		if err != nil {
+			return err
+		}
+		for i := range exoCfgs {
+			if err := awsClient.RemoveExocomputeConfig(ctx, exoCfgs[i].ID); err != nil {
+				return fmt.Errorf("failed to remove AWS ExocomputeConfig: %v", pretty.Sprint(exoCfgs[i]))
+			}
+		}
+
 		features := make([]core.Feature, 0, len(awsAccount.Features))
 		for _, feature := range awsAccount.Features {
 			features = append(features, feature.Feature)
@@ -215,9 +226,10 @@ func clean(ctx context.Context, client *polaris.Client) error {
 			}
 		}
 
-		// Remove all features for the subscription.
-		for _, feature := range azureAcc.Features {
-			if err := azureClient.RemoveSubscription(ctx, azure.CloudAccountID(azureAcc.ID), feature.Feature, false); err != nil {
+		// Remove all features for the subscription, child features before
+		// their parent features.
+		for _, feature := range core.FeatureRemovalOrder(azureAcc.AccountFeatures()) {
+			if err := azureClient.RemoveSubscription(ctx, azure.CloudAccountID(azureAcc.ID), feature, false); err != nil {
 				return fmt.Errorf("failed to remove Azure cloud account fetaure %v: %s", feature.Name, err)
 			}
 		}
@@ -15,6 +15,7 @@ require (
 	github.com/golang-jwt/jwt/v4 v4.0.0
 	github.com/google/uuid v1.3.1
 	github.com/kr/pretty v0.1.0
+	github.com/vektah/gqlparser/v2 v2.5.11
 	golang.org/x/oauth2 v0.11.0
 	golang.org/x/sync v0.3.0
 	golang.org/x/text v0.14.0
@@ -0,0 +1,63 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package querygen holds the naming rules shared by queries_gen.go, which
+// generates the query variables of the graphql packages, and the tests
+// verifying the generated files.
+package querygen
+
+import (
+	"strings"
+)
+
+// VariableName creates a Golang variable name, without the Query suffix, from
+// the query file name by trimming the file suffix, removing underscore
+// characters and capitalizing the character after. The first character is not
+// capitalized to prevent the name from being exported.
+func VariableName(fileName string) string {
+	var sb strings.Builder
+
+	name := strings.TrimSuffix(strings.ToLower(fileName), ".graphql")
+	for i, part := range strings.Split(name, "_") {
+		if i == 0 {
+			sb.WriteString(part)
+		} else {
+			sb.WriteString(title(part))
+		}
+	}
+
+	return sb.String()
+}
+
+// Query returns the query with the placeholder operation name of the query
+// file replaced with the operation name derived from the variable name.
+func Query(variableName, content string) string {
+	return strings.Replace(strings.TrimSpace(content), "RubrikPolarisSDKRequest", "SdkGolang"+title(variableName), 1)
+}
+
+// title returns the string with the first character in upper case. Note, the
+// query file names only contain ASCII characters.
+func title(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package testquery verifies the queries of the graphql packages in unit tests.
+package testquery
+
+import (
+	"errors"
+	"fmt"
+	goast "go/ast"
+	goparser "go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/querygen"
+)
+
+// ValidateQueries verifies that the GraphQL files in the queries directory are
+// well-formed and that the generated Go file is up to date with them. Each
+// GraphQL file must parse as a single named query or mutation.
+// Each GraphQL file must have a matching variable in the generated file and
+// each query variable in the generated file must have a matching GraphQL file.
+// The queries of all packages using queries_gen.go are validated by the unit
+// tests of this package.
+func ValidateQueries(queriesDir, generatedFile string) error {
+	generated, err := generatedQueries(generatedFile)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	err = filepath.WalkDir(queriesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(d.Name(), ".graphql") {
+			return err
+		}
+
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		name := querygen.VariableName(d.Name())
+		query := querygen.Query(name, string(buf))
+		if err := validateQuery(query); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", path, err))
+		}
+
+		value, ok := generated[name+"Query"]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("%s: no %sQuery variable in %s", path, name, generatedFile))
+		case value != query:
+			errs = append(errs, fmt.Errorf("%s: %sQuery variable in %s is stale", path, name, generatedFile))
+		}
+		delete(generated, name+"Query")
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read queries: %s", err)
+	}
+	for name := range generated {
+		errs = append(errs, fmt.Errorf("%s: %s variable has no GraphQL file in %s", generatedFile, name, queriesDir))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateQuery verifies that the query parses as a GraphQL document holding
+// a single query/mutation with an operation name generated by queries_gen.go.
+func validateQuery(query string) error {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return err
+	}
+	if len(doc.Operations) != 1 {
+		return fmt.Errorf("expected 1 operation, found %d", len(doc.Operations))
+	}
+	op := doc.Operations[0]
+	if op.Operation != ast.Query && op.Operation != ast.Mutation {
+		return fmt.Errorf("unsupported operation type %q", op.Operation)
+	}
+	if !strings.HasPrefix(op.Name, "SdkGolang") {
+		return fmt.Errorf("invalid operation name %q", op.Name)
+	}
+
+	return nil
+}
+
+// generatedQueries returns the query variables, and their values, declared in
+// the generated Go file.
+func generatedQueries(generatedFile string) (map[string]string, error) {
+	file, err := goparser.ParseFile(token.NewFileSet(), generatedFile, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse generated file: %s", err)
+	}
+
+	queries := make(map[string]string)
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*goast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*goast.ValueSpec)
+			for i, name := range valueSpec.Names {
+				if !strings.HasSuffix(name.Name, "Query") || i >= len(valueSpec.Values) {
+					continue
+				}
+				lit, ok := valueSpec.Values[i].(*goast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				value, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					return nil, fmt.Errorf("failed to unquote %s: %s", name.Name, err)
+				}
+				queries[name.Name] = value
+			}
+		}
+	}
+
+	return queries, nil
+}
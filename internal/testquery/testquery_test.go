@@ -0,0 +1,66 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package testquery
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateQueries(t *testing.T) {
+	packages := []string{"access", "archival", "aws", "azure", "core", "exocompute", "gcp"}
+	for _, pkg := range packages {
+		t.Run(pkg, func(t *testing.T) {
+			dir := filepath.Join("..", "..", "pkg", "polaris", "graphql", pkg)
+			if err := ValidateQueries(filepath.Join(dir, "queries"), filepath.Join(dir, "queries.go")); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestValidateQuery(t *testing.T) {
+	valid := []string{
+		"query SdkGolangMe { me { name } }",
+		"mutation SdkGolangAddUser($email: String!) { result: addUser(email: $email, note: \"a { b\") }",
+	}
+	for _, query := range valid {
+		if err := validateQuery(query); err != nil {
+			t.Errorf("query %q should be valid: %s", query, err)
+		}
+	}
+
+	invalid := []string{
+		"{ me { name } }",
+		"query RubrikPolarisSDKRequest { me { name } }",
+		"query SdkGolangMe { me { name }",
+		"query SdkGolangMe { me ( name } }",
+		"query SdkGolangMe { me(note: \"name) }",
+		"query SdkGolangMe { me(id: ) { name } }",
+		"query SdkGolangMe { me { name } } query SdkGolangYou { you { name } }",
+		"subscription SdkGolangMe { me { name } }",
+	}
+	for _, query := range invalid {
+		if err := validateQuery(query); err == nil {
+			t.Errorf("query %q should be invalid", query)
+		}
+	}
+}
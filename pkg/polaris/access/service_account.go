@@ -0,0 +1,136 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/access"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// ServiceAccount represents a service account in RSC. LastLogin is the zero
+// time if the service account has never been used.
+type ServiceAccount struct {
+	ClientID    string
+	Name        string
+	Description string
+	LastLogin   time.Time
+	Roles       []Role
+}
+
+// ServiceAccountCredentials holds the credentials of a newly created service
+// account. The JSON encoding of the credentials matches the RSC service account
+// file format, so the credentials can be stored as a service account file and
+// loaded with polaris.ServiceAccountFromFile.
+type ServiceAccountCredentials struct {
+	ClientID       string `json:"client_id"`
+	ClientSecret   string `json:"client_secret"`
+	Name           string `json:"name"`
+	AccessTokenURI string `json:"access_token_uri"`
+}
+
+// ServiceAccount returns the service account with the specified name.
+func (a API) ServiceAccount(ctx context.Context, name string) (ServiceAccount, error) {
+	a.log.Print(log.Trace)
+
+	accounts, err := a.ServiceAccounts(ctx, name)
+	if err != nil {
+		return ServiceAccount{}, fmt.Errorf("failed to get service accounts: %v", err)
+	}
+
+	for _, account := range accounts {
+		if account.Name == name {
+			return account, nil
+		}
+	}
+
+	return ServiceAccount{}, fmt.Errorf("service account %q %w", name, graphql.ErrNotFound)
+}
+
+// ServiceAccounts returns the service accounts matching the specified name
+// filter.
+func (a API) ServiceAccounts(ctx context.Context, nameFilter string) ([]ServiceAccount, error) {
+	a.log.Print(log.Trace)
+
+	accessAccounts, err := access.Wrap(a.client).ServiceAccounts(ctx, nameFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service accounts: %v", err)
+	}
+
+	accounts := make([]ServiceAccount, 0, len(accessAccounts))
+	for _, account := range accessAccounts {
+		var lastLogin time.Time
+		if account.LastLogin != nil {
+			lastLogin = *account.LastLogin
+		}
+		accounts = append(accounts, ServiceAccount{
+			ClientID:    account.ClientID,
+			Name:        account.Name,
+			Description: account.Description,
+			LastLogin:   lastLogin,
+			Roles:       toRoles(account.Roles),
+		})
+	}
+
+	return accounts, nil
+}
+
+// AddServiceAccount adds a new service account with the specified name,
+// description and roles. Note that the client secret of the returned
+// credentials cannot be retrieved again after the service account has been
+// created.
+func (a API) AddServiceAccount(ctx context.Context, name, description string, roleIDs []uuid.UUID) (ServiceAccountCredentials, error) {
+	a.log.Print(log.Trace)
+
+	if len(roleIDs) == 0 {
+		return ServiceAccountCredentials{}, errors.New("a service account needs at least one role")
+	}
+
+	creds, err := access.Wrap(a.client).CreateServiceAccount(ctx, name, description, roleIDs)
+	if err != nil {
+		return ServiceAccountCredentials{}, fmt.Errorf("failed to add service account: %v", err)
+	}
+
+	return ServiceAccountCredentials{
+		Name:           creds.Name,
+		ClientID:       creds.ClientID,
+		ClientSecret:   creds.ClientSecret,
+		AccessTokenURI: creds.AccessTokenURI,
+	}, nil
+}
+
+// RemoveServiceAccount removes the service account with the specified client
+// ID.
+func (a API) RemoveServiceAccount(ctx context.Context, clientID string) error {
+	a.log.Print(log.Trace)
+
+	if err := access.Wrap(a.client).DeleteServiceAccountsFromAccount(ctx, []string{clientID}); err != nil {
+		return fmt.Errorf("failed to remove service account: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package access
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+func TestServiceAccountLifecycle(t *testing.T) {
+	gqlClient, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	accessClient := Wrap(&polaris.Client{GQL: gqlClient})
+
+	// Serve a single service account. Deleting any other service account
+	// returns a false result.
+	roleID := uuid.MustParse("a0a1a2a3-0000-4000-8000-000000000001")
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		var payload struct {
+			Query     string `json:"query"`
+			Variables struct {
+				After   string   `json:"after"`
+				IDs     []string `json:"ids"`
+				RoleIDs []string `json:"roleIds"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch graphql.QueryName(payload.Query) {
+		case "createServiceAccount":
+			if !slices.Equal(payload.Variables.RoleIDs, []string{roleID.String()}) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"data": {"result": {"clientId": "client|ci", "clientSecret": "secret", "name": "ci",
+				"accessTokenUri": "https://example.my.rubrik.com/api/client_token"}}}`)
+		case "serviceAccounts":
+			// Return the service account on a second page to exercise paging.
+			if payload.Variables.After == "" {
+				fmt.Fprint(w, `{"data": {"result": {"edges": [], "pageInfo": {"endCursor": "page-2", "hasNextPage": true}}}}`)
+				return
+			}
+			fmt.Fprintf(w, `{"data": {"result": {"edges": [{"node": {"clientId": "client|ci", "name": "ci", "lastLogin": null,
+				"roles": [{"id": "%s", "name": "CI"}]}}], "pageInfo": {"endCursor": "", "hasNextPage": false}}}}`, roleID)
+		case "deleteServiceAccountsFromAccount":
+			fmt.Fprintf(w, `{"data": {"result": %t}}`, slices.Equal(payload.Variables.IDs, []string{"client|ci"}))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer srv.Shutdown(context.Background())
+
+	if _, err := accessClient.AddServiceAccount(context.Background(), "ci", "", nil); err == nil {
+		t.Error("service account without roles should fail")
+	}
+	creds, err := accessClient.AddServiceAccount(context.Background(), "ci", "", []uuid.UUID{roleID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.ClientID != "client|ci" || creds.ClientSecret != "secret" {
+		t.Errorf("invalid credentials: %v", creds)
+	}
+
+	account, err := accessClient.ServiceAccount(context.Background(), "ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.ClientID != "client|ci" || !account.LastLogin.IsZero() || len(account.Roles) != 1 || account.Roles[0].ID != roleID {
+		t.Errorf("invalid service account: %v", account)
+	}
+
+	if err := accessClient.RemoveServiceAccount(context.Background(), "client|ci"); err != nil {
+		t.Error(err)
+	}
+	if err := accessClient.RemoveServiceAccount(context.Background(), "client|other"); err == nil {
+		t.Error("false delete result should fail")
+	}
+}
@@ -24,10 +24,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	graphqlaws "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/aws"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
@@ -49,7 +53,7 @@ type AccountFunc func(ctx context.Context) (account, error)
 // the specified AWS configuration and values from the AWS cloud.
 func Config(config aws.Config) AccountFunc {
 	return func(ctx context.Context) (account, error) {
-		id, name, err := awsAccountInfo(ctx, config)
+		cloud, id, name, err := awsAccountInfo(ctx, config)
 		if err != nil {
 			return account{}, fmt.Errorf("failed to access AWS account: %v", err)
 		}
@@ -58,7 +62,7 @@ func Config(config aws.Config) AccountFunc {
 			name = id
 		}
 
-		return account{id: id, name: name, config: &config}, nil
+		return account{cloud: cloud, id: id, name: name, config: &config}, nil
 	}
 }
 
@@ -149,7 +153,7 @@ func ProfileWithRegionAndRole(profile, region, roleARN string) AccountFunc {
 			config.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, roleARN))
 		}
 
-		id, name, err := awsAccountInfo(ctx, config)
+		cloud, id, name, err := awsAccountInfo(ctx, config)
 		if err != nil {
 			return account{}, fmt.Errorf("failed to access AWS account: %v", err)
 		}
@@ -157,27 +161,79 @@ func ProfileWithRegionAndRole(profile, region, roleARN string) AccountFunc {
 			name = id + " : " + profile
 		}
 
-		return account{cloud: graphqlaws.CloudStandard, id: id, name: name, config: &config}, nil
+		return account{cloud: cloud, id: id, name: name, config: &config}, nil
 	}
 }
 
-// awsAccount returns the account id and name. Note that if the AWS user does
+// WebIdentity returns an AccountFunc that initializes the account using
+// credentials obtained by assuming the role specified by the role ARN with the
+// web identity token in the token file, e.g. IAM roles for service accounts
+// (IRSA) in EKS. If the role ARN or the token file is empty, the AWS_ROLE_ARN
+// and AWS_WEB_IDENTITY_TOKEN_FILE environment variables are used. The region
+// is read from the default profile and the environment.
+func WebIdentity(roleARN, tokenFile string) AccountFunc {
+	return func(ctx context.Context) (account, error) {
+		if roleARN == "" {
+			roleARN = os.Getenv("AWS_ROLE_ARN")
+		}
+		if tokenFile == "" {
+			tokenFile = os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+		}
+		if roleARN == "" || tokenFile == "" {
+			return account{}, errors.New("both role ARN and web identity token file must be specified")
+		}
+
+		config, err := config.LoadDefaultConfig(ctx)
+		if err != nil {
+			return account{}, fmt.Errorf("failed to load default profile: %v", err)
+		}
+		if config.Region == "" {
+			return account{}, errors.New("missing AWS region, used for AWS CloudFormation stack operations")
+		}
+
+		stsClient := sts.NewFromConfig(config)
+		config.Credentials = aws.NewCredentialsCache(
+			stscreds.NewWebIdentityRoleProvider(stsClient, roleARN, stscreds.IdentityTokenFile(tokenFile)))
+
+		cloud, id, name, err := awsAccountInfo(ctx, config)
+		if err != nil {
+			return account{}, fmt.Errorf("failed to access AWS account: %v", err)
+		}
+		if name == "" {
+			name = id
+		}
+
+		return account{cloud: cloud, id: id, name: name, config: &config}, nil
+	}
+}
+
+// awsAccountInfo returns the cloud, account id and name. The cloud is derived
+// from the partition of the caller identity. Note that if the AWS user does
 // not have permissions for Organizations the account name will be empty.
-func awsAccountInfo(ctx context.Context, config aws.Config) (string, string, error) {
+func awsAccountInfo(ctx context.Context, config aws.Config) (graphqlaws.Cloud, string, string, error) {
 	stsClient := sts.NewFromConfig(config)
 	callerID, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get AWS identity from STS: %v", err)
+		return "", "", "", fmt.Errorf("failed to get AWS identity from STS: %v", err)
+	}
+
+	callerARN, err := arn.Parse(*callerID.Arn)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to parse AWS identity ARN: %v", err)
+	}
+	cloud, err := graphqlaws.CloudFromPartition(callerARN.Partition)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to get AWS cloud from identity ARN: %v", err)
 	}
 
 	// Organizations call might fail due to missing permissions.
 	orgClient := organizations.NewFromConfig(config)
 	info, err := orgClient.DescribeAccount(ctx, &organizations.DescribeAccountInput{AccountId: callerID.Account})
 	if err != nil {
-		return *callerID.Account, "", nil
+		return cloud, *callerID.Account, "", nil
 	}
 
-	return *callerID.Account, *info.Account.Name, nil
+	return cloud, *callerID.Account, *info.Account.Name, nil
 }
 
 // Account returns an AccountFunc that initializes the account with specified
@@ -202,3 +258,71 @@ func AccountWithName(cloud, awsAccountID, name string) AccountFunc {
 		return account{cloud: c, id: awsAccountID, name: name}, nil
 	}
 }
+
+// ValidateCrossAccountRole validates the specified cross account role ARN. The
+// ARN must be an IAM role ARN for a valid AWS account id. After the format has
+// been validated, the role is assumed using the default profile, the same way
+// DefaultWithRole assumes the role, to catch misconfigured trust relationships
+// before onboarding the account.
+func (a API) ValidateCrossAccountRole(ctx context.Context, roleARN string) error {
+	a.log.Print(log.Trace)
+
+	partition, err := validateRoleARN(roleARN)
+	if err != nil {
+		return err
+	}
+
+	config, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load default profile: %s", err)
+	}
+	if config.Region == "" {
+		config.Region = partitionRegion(partition)
+	}
+
+	stsClient := sts.NewFromConfig(config)
+	if _, err := stsClient.AssumeRole(ctx, &sts.AssumeRoleInput{
+		RoleArn:         aws.String(roleARN),
+		RoleSessionName: aws.String("rubrik-polaris-sdk-role-validation"),
+	}); err != nil {
+		return fmt.Errorf("failed to assume role %q, verify the trust relationship of the role: %s", roleARN, err)
+	}
+
+	return nil
+}
+
+// validateRoleARN returns the partition of the role ARN if the ARN is a valid
+// IAM role ARN, otherwise an error is returned.
+func validateRoleARN(roleARN string) (string, error) {
+	roleARNParsed, err := arn.Parse(roleARN)
+	if err != nil {
+		return "", fmt.Errorf("invalid role ARN %q: %s", roleARN, err)
+	}
+	if _, err := graphqlaws.CloudFromPartition(roleARNParsed.Partition); err != nil {
+		return "", fmt.Errorf("invalid role ARN %q: %s", roleARN, err)
+	}
+	if roleARNParsed.Service != "iam" {
+		return "", fmt.Errorf("invalid role ARN %q: service must be iam", roleARN)
+	}
+	if !verifyAccountID(roleARNParsed.AccountID) {
+		return "", fmt.Errorf("invalid role ARN %q: invalid AWS account id", roleARN)
+	}
+	if name, ok := strings.CutPrefix(roleARNParsed.Resource, "role/"); !ok || name == "" {
+		return "", fmt.Errorf("invalid role ARN %q: resource must be a role", roleARN)
+	}
+
+	return roleARNParsed.Partition, nil
+}
+
+// partitionRegion returns a region which can be used for STS requests in the
+// specified partition.
+func partitionRegion(partition string) string {
+	switch partition {
+	case "aws-cn":
+		return "cn-north-1"
+	case "aws-us-gov":
+		return "us-gov-west-1"
+	default:
+		return "us-east-1"
+	}
+}
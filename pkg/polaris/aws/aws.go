@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -65,6 +66,37 @@ type CloudAccount struct {
 	Features []Feature
 }
 
+var _ core.CloudAccount = CloudAccount{}
+
+// AccountID returns the RSC cloud account ID.
+func (c CloudAccount) AccountID() uuid.UUID {
+	return c.ID
+}
+
+// AccountNativeID returns the AWS account ID.
+func (c CloudAccount) AccountNativeID() string {
+	return c.NativeID
+}
+
+// AccountName returns the name of the cloud account.
+func (c CloudAccount) AccountName() string {
+	return c.Name
+}
+
+// CloudVendor returns core.CloudVendorAWS.
+func (c CloudAccount) CloudVendor() core.CloudVendor {
+	return core.CloudVendorAWS
+}
+
+// AccountFeatures returns the RSC features of the cloud account.
+func (c CloudAccount) AccountFeatures() []core.Feature {
+	features := make([]core.Feature, 0, len(c.Features))
+	for _, feature := range c.Features {
+		features = append(features, feature.Feature)
+	}
+	return features
+}
+
 // Feature returns the specified feature from the CloudAccount's features.
 func (c CloudAccount) Feature(feature core.Feature) (Feature, bool) {
 	for _, f := range c.Features {
@@ -76,13 +108,59 @@ func (c CloudAccount) Feature(feature core.Feature) (Feature, bool) {
 	return Feature{}, false
 }
 
+// RegionsForFeature returns the regions of the specified feature. If the
+// feature isn't onboarded for the CloudAccount, nil is returned.
+func (c CloudAccount) RegionsForFeature(feature core.Feature) []string {
+	f, ok := c.Feature(feature)
+	if !ok {
+		return nil
+	}
+
+	return slices.Clone(f.Regions)
+}
+
+// FeaturesInRegion returns the features of the CloudAccount which are enabled
+// for the specified region.
+func (c CloudAccount) FeaturesInRegion(region string) []core.Feature {
+	var features []core.Feature
+	for _, f := range c.Features {
+		if f.HasRegion(region) {
+			features = append(features, f.Feature)
+		}
+	}
+
+	return features
+}
+
+// FeatureMap returns the regions of the CloudAccount's features, keyed by
+// feature name.
+func (c CloudAccount) FeatureMap() map[string][]string {
+	features := make(map[string][]string, len(c.Features))
+	for _, f := range c.Features {
+		features[f.Name] = slices.Clone(f.Regions)
+	}
+
+	return features
+}
+
+// FeatureStatuses returns the status of the CloudAccount's features, keyed by
+// feature name.
+func (c CloudAccount) FeatureStatuses() map[string]core.FeatureStatus {
+	statuses := make(map[string]core.FeatureStatus, len(c.Features))
+	for _, f := range c.Features {
+		statuses[f.Name] = f.Status
+	}
+
+	return statuses
+}
+
 // Feature for Amazon Web Services accounts.
 type Feature struct {
 	core.Feature
 	Regions  []string
 	RoleArn  string
 	StackArn string
-	Status   core.Status
+	Status   core.FeatureStatus
 }
 
 // HasRegion returns true if the feature is enabled for the specified region.
@@ -305,6 +383,9 @@ func (a API) AddAccount(ctx context.Context, account AccountFunc, features []cor
 	if account == nil {
 		return uuid.Nil, errors.New("account is not allowed to be nil")
 	}
+	if err := core.ValidateFeatures(features...); err != nil {
+		return uuid.Nil, err
+	}
 	config, err := account(ctx)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("failed to lookup account: %s", err)
@@ -319,6 +400,12 @@ func (a API) AddAccount(ctx context.Context, account AccountFunc, features []cor
 	if options.name != "" {
 		config.name = options.name
 	}
+	if err := aws.ValidateRegions(config.cloud, options.regions); err != nil {
+		return uuid.Nil, err
+	}
+	if len(options.tags) > 0 && config.config == nil {
+		return uuid.Nil, errors.New("tags require an account added using a CloudFormation stack")
+	}
 
 	// If there already is an RSC cloud account for the given AWS account we use
 	// the same account name when adding the feature. RSC does not allow the
@@ -330,6 +417,9 @@ func (a API) AddAccount(ctx context.Context, account AccountFunc, features []cor
 	if err != nil && !errors.Is(err, graphql.ErrNotFound) {
 		return uuid.Nil, fmt.Errorf("failed to get account: %s", err)
 	}
+	if err := core.ValidateFeatureParents(features, akkount.AccountFeatures()); err != nil {
+		return uuid.Nil, err
+	}
 
 	if config.config != nil {
 		err = a.addAccountWithCFT(ctx, features, config, options)
@@ -384,7 +474,7 @@ func (a API) addAccountWithCFT(ctx context.Context, features []core.Feature, con
 		return fmt.Errorf("failed to add account: %s", err)
 	}
 
-	err = awsUpdateStack(ctx, a.client.Log(), *config.config, accountInit.StackName, accountInit.TemplateURL)
+	err = awsUpdateStack(ctx, a.client.Log(), *config.config, accountInit.StackName, accountInit.TemplateURL, options.tags)
 	if err != nil {
 		return fmt.Errorf("failed to update CloudFormation stack: %s", err)
 	}
@@ -419,6 +509,9 @@ func (a API) RemoveAccount(ctx context.Context, account AccountFunc, features []
 		}
 	}
 
+	// Child features must be removed before their parent features.
+	features = core.FeatureRemovalOrder(features)
+
 	if config.config != nil {
 		for _, feature := range features {
 			if err := a.removeAccountWithCFT(ctx, config, cloudAccount, feature, deleteSnapshots); err != nil {
@@ -487,7 +580,7 @@ func (a API) removeAccountWithCFT(ctx context.Context, config account, account C
 			stackID := u.Query().Get("stackId")
 			tmplURL := u.Query().Get("templateURL")
 
-			err = awsUpdateStack(ctx, a.client.Log(), *config.config, stackID, tmplURL)
+			err = awsUpdateStack(ctx, a.client.Log(), *config.config, stackID, tmplURL, nil)
 			if err != nil {
 				return fmt.Errorf("failed to update CloudFormation stack: %s", err)
 			}
@@ -611,12 +704,19 @@ func (a API) disableProtectionFeature(ctx context.Context, cloudAccountID uuid.U
 func (a API) UpdateAccount(ctx context.Context, id IdentityFunc, feature core.Feature, opts ...OptionFunc) error {
 	a.log.Print(log.Trace)
 
+	if err := core.ValidateFeatures(feature); err != nil {
+		return err
+	}
+
 	var options options
 	for _, option := range opts {
 		if err := option(ctx, &options); err != nil {
 			return fmt.Errorf("failed to lookup option: %s", err)
 		}
 	}
+	if len(options.tags) > 0 {
+		return errors.New("tags cannot be updated")
+	}
 
 	accountID, err := a.toCloudAccountID(ctx, id)
 	if err != nil {
@@ -630,6 +730,13 @@ func (a API) UpdateAccount(ctx context.Context, id IdentityFunc, feature core.Fe
 	}
 
 	if len(options.regions) > 0 {
+		account, err := a.Account(ctx, CloudAccountID(accountID), feature)
+		if err != nil {
+			return fmt.Errorf("failed to get account: %s", err)
+		}
+		if err := aws.ValidateRegions(aws.Cloud(account.Cloud), options.regions); err != nil {
+			return err
+		}
 		if err := aws.Wrap(a.client).UpdateCloudAccountFeature(ctx, core.UpdateRegions, accountID, feature, options.regions); err != nil {
 			return fmt.Errorf("failed to update account: %s", err)
 		}
@@ -638,6 +745,88 @@ func (a API) UpdateAccount(ctx context.Context, id IdentityFunc, feature core.Fe
 	return nil
 }
 
+// EnsureAccount makes sure the account is onboarded with the specified
+// features. If the account doesn't exist, it's added. If the account exists,
+// missing features are added and the regions and name of the account are
+// updated to match the desired state given by the options. Returns the RSC
+// cloud account ID and true if a change was made.
+func (a API) EnsureAccount(ctx context.Context, account AccountFunc, features []core.Feature, opts ...OptionFunc) (uuid.UUID, bool, error) {
+	a.log.Print(log.Trace)
+
+	if account == nil {
+		return uuid.Nil, false, errors.New("account is not allowed to be nil")
+	}
+	config, err := account(ctx)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("failed to lookup account: %s", err)
+	}
+
+	var options options
+	for _, option := range opts {
+		if err := option(ctx, &options); err != nil {
+			return uuid.Nil, false, fmt.Errorf("failed to lookup option: %s", err)
+		}
+	}
+
+	existing, err := a.Account(ctx, AccountID(config.id), core.FeatureAll)
+	if errors.Is(err, graphql.ErrNotFound) {
+		id, err := a.AddAccount(ctx, account, features, opts...)
+		if err != nil {
+			return uuid.Nil, false, err
+		}
+		return id, true, nil
+	}
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("failed to get account: %s", err)
+	}
+
+	regions := aws.FormatRegions(options.regions)
+	var missing, outdated []core.Feature
+	for _, feature := range features {
+		existingFeature, ok := existing.Feature(feature)
+		switch {
+		case !ok || existingFeature.Status == core.StatusDisabled:
+			missing = append(missing, feature)
+		case len(regions) > 0 && !sameRegions(existingFeature.Regions, regions):
+			outdated = append(outdated, feature)
+		}
+	}
+
+	changed := false
+	if len(missing) > 0 {
+		if _, err := a.AddAccount(ctx, account, missing, opts...); err != nil {
+			return uuid.Nil, false, err
+		}
+		changed = true
+	}
+	for _, feature := range outdated {
+		if err := a.UpdateAccount(ctx, CloudAccountID(existing.ID), feature, Regions(regions...)); err != nil {
+			return uuid.Nil, false, err
+		}
+		changed = true
+	}
+	if options.name != "" && options.name != existing.Name && len(features) > 0 {
+		if err := a.UpdateAccount(ctx, CloudAccountID(existing.ID), features[0], Name(options.name)); err != nil {
+			return uuid.Nil, false, err
+		}
+		changed = true
+	}
+
+	return existing.ID, changed, nil
+}
+
+// sameRegions returns true if the two slices hold the same set of regions.
+func sameRegions(regions, other []string) bool {
+	regions = slices.Clone(regions)
+	slices.Sort(regions)
+	regions = slices.Compact(regions)
+	other = slices.Clone(other)
+	slices.Sort(other)
+	other = slices.Compact(other)
+
+	return slices.Equal(regions, other)
+}
+
 const (
 	roleArnSuffix         = "_ROLE_ARN"
 	instanceProfileSuffix = "_INSTANCE_PROFILE"
@@ -720,6 +909,10 @@ func (a API) AccountArtifacts(ctx context.Context, id IdentityFunc) (map[string]
 func (a API) AddAccountArtifacts(ctx context.Context, id IdentityFunc, features []core.Feature, instanceProfiles map[string]string, roles map[string]string) (uuid.UUID, error) {
 	a.log.Print(log.Trace)
 
+	if err := core.ValidateFeatures(features...); err != nil {
+		return uuid.Nil, err
+	}
+
 	account, err := a.Account(ctx, id, core.FeatureAll)
 	if err != nil {
 		return uuid.Nil, err
@@ -786,13 +979,29 @@ func (a API) AddAccountArtifacts(ctx context.Context, id IdentityFunc, features
 }
 
 // TrustPolicies returns the trust policies required by RSC for the specified
-// features. If the external ID is empty, RSC will generate an external ID.
+// features. If the external ID is empty, RSC will generate an external ID. Use
+// TrustPoliciesWithExternalID to also get the external ID used.
 func (a API) TrustPolicies(ctx context.Context, id IdentityFunc, features []core.Feature, externalID string) (map[string]string, error) {
 	a.log.Print(log.Trace)
 
+	trustPolicies, _, err := a.TrustPoliciesWithExternalID(ctx, id, features, externalID)
+	return trustPolicies, err
+}
+
+// TrustPoliciesWithExternalID returns the trust policies required by RSC for
+// the specified features together with the external ID used by the trust
+// policies. If the external ID is empty, RSC will generate an external ID,
+// which is returned. Note, RSC doesn't expose the external ID of an
+// account once the trust policies have been created, so the returned external
+// ID must be stored by the caller to be able to reconstruct the trust
+// relationships of the roles later. Calling TrustPoliciesWithExternalID again
+// with an empty external ID can return a newly generated external ID.
+func (a API) TrustPoliciesWithExternalID(ctx context.Context, id IdentityFunc, features []core.Feature, externalID string) (map[string]string, string, error) {
+	a.log.Print(log.Trace)
+
 	account, err := a.Account(ctx, id, core.FeatureAll)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	policies, err := aws.Wrap(a.client).TrustPolicy(ctx, aws.Cloud(account.Cloud), features, []aws.TrustPolicyAccount{{
@@ -800,20 +1009,45 @@ func (a API) TrustPolicies(ctx context.Context, id IdentityFunc, features []core
 		ExternalID: externalID,
 	}})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get trust policies: %s", err)
+		return nil, "", fmt.Errorf("failed to get trust policies: %s", err)
 	}
 	if len(policies) != 1 {
-		return nil, fmt.Errorf("expected trust policies for a single account")
+		return nil, "", fmt.Errorf("expected trust policies for a single account")
 	}
 
 	trustPolicies := make(map[string]string)
 	for _, artifact := range policies[0].Artifacts {
 		if msg := artifact.ErrorMessage; msg != "" {
-			return nil, fmt.Errorf("failed to get trust policies: %s", msg)
+			return nil, "", fmt.Errorf("failed to get trust policies: %s", msg)
 		}
 		artifact.ExternalArtifactKey = strings.TrimSuffix(artifact.ExternalArtifactKey, roleArnSuffix)
 		trustPolicies[artifact.ExternalArtifactKey] = artifact.TrustPolicyDoc
+
+		if externalID == "" {
+			if externalID, err = aws.ExternalIDFromTrustPolicy(artifact.TrustPolicyDoc); err != nil {
+				return nil, "", fmt.Errorf("failed to get external id from trust policy: %s", err)
+			}
+		}
 	}
 
-	return trustPolicies, nil
+	return trustPolicies, externalID, nil
+}
+
+// WaitForFeatureStatus blocks until the feature of the account with the
+// specified id reaches the specified status. If the feature reaches a terminal
+// status other than the specified status, an error is returned.
+func (a API) WaitForFeatureStatus(ctx context.Context, id IdentityFunc, feature core.Feature, status core.FeatureStatus) error {
+	a.log.Print(log.Trace)
+
+	return core.Wrap(a.client).WaitForFeatureStatus(ctx, status, 10*time.Second, func(ctx context.Context) (core.FeatureStatus, error) {
+		account, err := a.Account(ctx, id, feature)
+		if err != nil {
+			return "", err
+		}
+		f, ok := account.Feature(feature)
+		if !ok {
+			return "", fmt.Errorf("feature %s %w", feature, graphql.ErrNotFound)
+		}
+		return f.Status, nil
+	})
 }
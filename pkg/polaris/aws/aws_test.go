@@ -23,13 +23,23 @@ package aws
 import (
 	"cmp"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"reflect"
 	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/google/uuid"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testsetup"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
@@ -344,3 +354,236 @@ func TestAwsCrossAccountAddAndRemove(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestValidateRoleARN(t *testing.T) {
+	if partition, err := validateRoleARN("arn:aws:iam::123456789012:role/rubrik-cross-account"); err != nil || partition != "aws" {
+		t.Errorf("invalid partition: %q, %v", partition, err)
+	}
+	if partition, err := validateRoleARN("arn:aws-us-gov:iam::123456789012:role/path/rubrik"); err != nil || partition != "aws-us-gov" {
+		t.Errorf("invalid partition: %q, %v", partition, err)
+	}
+
+	for _, roleARN := range []string{
+		"",
+		"rubrik-cross-account",
+		"arn:aws:s3:::rubrik-bucket",
+		"arn:aws:iam::1234:role/rubrik-cross-account",
+		"arn:aws:iam::123456789012:user/rubrik",
+		"arn:aws:iam::123456789012:role/",
+		"arn:azure:iam::123456789012:role/rubrik-cross-account",
+	} {
+		if _, err := validateRoleARN(roleARN); err == nil {
+			t.Errorf("role ARN %q should be invalid", roleARN)
+		}
+	}
+}
+
+func TestCloudAccountRegions(t *testing.T) {
+	account := CloudAccount{
+		Features: []Feature{
+			{Feature: core.FeatureCloudNativeProtection, Regions: []string{"us-east-2", "us-west-2"}},
+			{Feature: core.FeatureExocompute, Regions: []string{"us-east-2"}},
+		},
+	}
+
+	if regions := account.RegionsForFeature(core.FeatureExocompute); !reflect.DeepEqual(regions, []string{"us-east-2"}) {
+		t.Errorf("invalid regions: %v", regions)
+	}
+	if regions := account.RegionsForFeature(core.FeatureRDSProtection); regions != nil {
+		t.Errorf("invalid regions: %v", regions)
+	}
+
+	features := account.FeaturesInRegion("us-east-2")
+	if len(features) != 2 || !features[0].Equal(core.FeatureCloudNativeProtection) || !features[1].Equal(core.FeatureExocompute) {
+		t.Errorf("invalid features: %v", features)
+	}
+	if features := account.FeaturesInRegion("eu-north-1"); len(features) != 0 {
+		t.Errorf("invalid features: %v", features)
+	}
+}
+
+func TestCloudAccountFeatureMap(t *testing.T) {
+	account := CloudAccount{
+		Features: []Feature{
+			{Feature: core.FeatureCloudNativeProtection, Regions: []string{"us-east-2", "us-west-2"}, Status: core.StatusConnected},
+			{Feature: core.FeatureExocompute, Regions: []string{"us-east-2"}, Status: core.StatusDisabled},
+		},
+	}
+
+	featureMap := account.FeatureMap()
+	if !reflect.DeepEqual(featureMap, map[string][]string{
+		core.FeatureCloudNativeProtection.Name: {"us-east-2", "us-west-2"},
+		core.FeatureExocompute.Name:            {"us-east-2"},
+	}) {
+		t.Errorf("invalid feature map: %v", featureMap)
+	}
+
+	statuses := account.FeatureStatuses()
+	if !reflect.DeepEqual(statuses, map[string]core.FeatureStatus{
+		core.FeatureCloudNativeProtection.Name: core.StatusConnected,
+		core.FeatureExocompute.Name:            core.StatusDisabled,
+	}) {
+		t.Errorf("invalid feature statuses: %v", statuses)
+	}
+}
+
+func TestWebIdentityMissingParameters(t *testing.T) {
+	t.Setenv("AWS_ROLE_ARN", "")
+	t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "")
+
+	if _, err := WebIdentity("", "/var/run/secrets/eks.amazonaws.com/serviceaccount/token")(context.Background()); err == nil {
+		t.Error("missing role ARN should fail")
+	}
+	if _, err := WebIdentity("arn:aws:iam::123456789012:role/rubrik", "")(context.Background()); err == nil {
+		t.Error("missing token file should fail")
+	}
+}
+
+func TestSameRegions(t *testing.T) {
+	if !sameRegions([]string{"us-east-2", "us-west-2"}, []string{"us-west-2", "us-east-2", "us-west-2"}) {
+		t.Error("regions should be the same")
+	}
+	if sameRegions([]string{"us-east-2"}, []string{"us-east-2", "us-west-2"}) {
+		t.Error("regions should differ")
+	}
+}
+
+// testOrganizationsClient returns the AWS Organization accounts in pages of
+// one account each.
+type testOrganizationsClient struct {
+	accounts []types.Account
+}
+
+func (c testOrganizationsClient) ListAccounts(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
+	i := 0
+	if params.NextToken != nil {
+		i, _ = strconv.Atoi(*params.NextToken)
+	}
+	output := &organizations.ListAccountsOutput{Accounts: c.accounts[i : i+1]}
+	if i+1 < len(c.accounts) {
+		output.NextToken = awssdk.String(strconv.Itoa(i + 1))
+	}
+
+	return output, nil
+}
+
+func TestOrganizationAccounts(t *testing.T) {
+	client := testOrganizationsClient{accounts: []types.Account{
+		{Id: awssdk.String("123456789012"), Name: awssdk.String("prod"), Status: types.AccountStatusActive},
+		{Id: awssdk.String("210987654321"), Name: awssdk.String("dev"), Status: types.AccountStatusSuspended},
+	}}
+	orgAccounts, err := listOrganizationAccounts(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orgAccounts) != 2 {
+		t.Fatalf("invalid number of accounts: %d", len(orgAccounts))
+	}
+
+	id := uuid.New()
+	accounts := organizationAccounts(orgAccounts, []CloudAccount{{ID: id, NativeID: "123456789012"}})
+	expected := []OrganizationAccount{
+		{NativeID: "123456789012", Name: "prod", Status: "ACTIVE", ID: id, Onboarded: true},
+		{NativeID: "210987654321", Name: "dev", Status: "SUSPENDED"},
+	}
+	if !reflect.DeepEqual(accounts, expected) {
+		t.Errorf("invalid accounts: %v", accounts)
+	}
+}
+
+func TestRecoverEC2Instance(t *testing.T) {
+	gqlClient, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	awsClient := Wrap(&polaris.Client{GQL: gqlClient})
+
+	// Respond with the destination account when the account is looked up and
+	// with the job id when the recovery is started, echoing the destination
+	// region and instance type of the request in the error field if they don't
+	// match.
+	accountID := uuid.MustParse("c0b1a2f3-0000-4000-8000-000000000002")
+	jobID := uuid.MustParse("a8b2c3d4-0000-4000-8000-000000000001")
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		var payload struct {
+			Query     string `json:"query"`
+			Variables struct {
+				AccountID    uuid.UUID `json:"destinationAwsAccountRubrikId"`
+				Region       string    `json:"destinationRegionId"`
+				InstanceType string    `json:"instanceType"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if graphql.QueryName(payload.Query) == "allAwsCloudAccountsWithFeatures" {
+			fmt.Fprintf(w, `{"data": {"result": [{"awsCloudAccount": {"cloudType": "STANDARD", "id": "%s", "nativeId": "123456789012"}, "featureDetails": []}]}}`, accountID)
+			return
+		}
+		if v := payload.Variables; v.Region != "EU_NORTH_1" || v.InstanceType != "m5.large" || v.AccountID == uuid.Nil {
+			fmt.Fprintf(w, `{"data": {"result": {"error": "unexpected variables: %s, %s"}}}`, v.Region, v.InstanceType)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"result": {"error": "", "jobId": "%s"}}}`, jobID)
+	})
+	defer srv.Shutdown(context.Background())
+
+	opts := RecoverEC2Options{
+		Account:      CloudAccountID(accountID),
+		Region:       "eu-north-1",
+		InstanceType: "m5.large",
+		SubnetID:     "subnet-0123456789",
+	}
+	snapshotID := uuid.MustParse("d0e1f2a3-0000-4000-8000-000000000003")
+	id, err := awsClient.RecoverEC2Instance(context.Background(), snapshotID, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != jobID {
+		t.Errorf("invalid job id: %s", id)
+	}
+
+	invalid := opts
+	invalid.Region = "eu-nort-1"
+	if _, err := awsClient.RecoverEC2Instance(context.Background(), snapshotID, invalid); err == nil {
+		t.Error("invalid region should fail")
+	}
+	invalid = opts
+	invalid.Region = "us-gov-west-1"
+	if _, err := awsClient.RecoverEC2Instance(context.Background(), snapshotID, invalid); err == nil {
+		t.Error("gov region should fail for a standard account")
+	}
+	invalid = opts
+	invalid.SubnetID = ""
+	if _, err := awsClient.RecoverEC2Instance(context.Background(), snapshotID, invalid); err == nil {
+		t.Error("empty subnet id should fail")
+	}
+	invalid = opts
+	invalid.Account = nil
+	if _, err := awsClient.RecoverEC2Instance(context.Background(), snapshotID, invalid); err == nil {
+		t.Error("nil account should fail")
+	}
+}
+
+func TestAddAccountTagsRequireCloudFormation(t *testing.T) {
+	gqlClient, _ := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	awsClient := Wrap(&polaris.Client{GQL: gqlClient})
+
+	// Tags can't be applied to an account added without a CloudFormation
+	// stack, the add fails before any request is made.
+	_, err := awsClient.AddAccount(context.Background(), AccountWithName("STANDARD", "123456789012", "test"),
+		[]core.Feature{core.FeatureCloudNativeProtection}, WithTags(map[string]string{"owner": "ops"}))
+	if err == nil || !strings.Contains(err.Error(), "tags") {
+		t.Errorf("expected tags error, got: %v", err)
+	}
+}
+
+func TestUpdateAccountTags(t *testing.T) {
+	gqlClient, _ := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	awsClient := Wrap(&polaris.Client{GQL: gqlClient})
+
+	// Tags can't be updated, the update fails before any request is made.
+	err := awsClient.UpdateAccount(context.Background(), CloudAccountID(uuid.New()),
+		core.FeatureCloudNativeProtection, WithTags(map[string]string{"owner": "ops"}))
+	if err == nil || !strings.Contains(err.Error(), "tags") {
+		t.Errorf("expected tags error, got: %v", err)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
@@ -46,6 +47,18 @@ type HealthCheckStatus struct {
 	TaskchainID   string
 }
 
+// IsHealthy returns true if the exocompute cluster is connected and ready to be
+// used.
+func (s HealthCheckStatus) IsHealthy() bool {
+	return s.Status == "CONNECTED"
+}
+
+// IsFailed returns true if the exocompute cluster is disconnected, with or
+// without a failure reason.
+func (s HealthCheckStatus) IsFailed() bool {
+	return s.Status == "DISCONNECTED"
+}
+
 // ExocomputeConfig represents a single exocompute config.
 type ExocomputeConfig struct {
 	ID      uuid.UUID
@@ -255,6 +268,40 @@ func (a API) ExocomputeConfig(ctx context.Context, configID uuid.UUID) (Exocompu
 	return ExocomputeConfig{}, fmt.Errorf("exocompute config %w", graphql.ErrNotFound)
 }
 
+// WaitForExocomputeConfig blocks until the exocompute cluster of the
+// exocompute config with the specified exocompute config id is healthy. The
+// poll parameter specifies the amount of time to wait before requesting
+// another health status update. If the cluster is disconnected, an error with
+// the failure reason, if any, is returned.
+func (a API) WaitForExocomputeConfig(ctx context.Context, configID uuid.UUID, poll time.Duration) error {
+	a.log.Print(log.Trace)
+
+	for {
+		config, err := a.ExocomputeConfig(ctx, configID)
+		if err != nil {
+			return err
+		}
+		if config.HealthCheckStatus.IsHealthy() {
+			return nil
+		}
+		if config.HealthCheckStatus.IsFailed() {
+			reason := config.HealthCheckStatus.FailureReason
+			if reason == "" {
+				reason = "no failure reason given"
+			}
+			return fmt.Errorf("exocompute cluster health check failed: %s", reason)
+		}
+
+		a.log.Printf(log.Debug, "Waiting for exocompute config %s, health status is %q", configID,
+			config.HealthCheckStatus.Status)
+		select {
+		case <-time.After(poll):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // ExocomputeConfigs returns all exocompute configs for the account with the
 // specified id.
 func (a API) ExocomputeConfigs(ctx context.Context, id IdentityFunc) ([]ExocomputeConfig, error) {
@@ -270,8 +317,13 @@ func (a API) ExocomputeConfigs(ctx context.Context, id IdentityFunc) ([]Exocompu
 		return nil, fmt.Errorf("failed to get exocompute configs for account: %s", err)
 	}
 
+	// The native id is used as a prefix filter, so only keep configs owned by
+	// the account with the exact native id.
 	var exoConfigs []ExocomputeConfig
 	for _, configsForAccount := range configsForAccounts {
+		if configsForAccount.Account.NativeID != nativeID {
+			continue
+		}
 		for _, config := range configsForAccount.Configs {
 			exoConfig, err := toExocomputeConfig(config)
 			if err != nil {
@@ -341,6 +393,35 @@ func (a API) RemoveExocomputeConfig(ctx context.Context, configID uuid.UUID) err
 	return nil
 }
 
+// RemoveExocomputeConfigAndWait removes the exocompute config with the
+// specified exocompute config id and blocks until the exocompute config is no
+// longer returned by RSC. The poll parameter specifies the amount of time to
+// wait before checking again whether the exocompute config has been removed.
+func (a API) RemoveExocomputeConfigAndWait(ctx context.Context, configID uuid.UUID, poll time.Duration) error {
+	a.log.Print(log.Trace)
+
+	if err := a.RemoveExocomputeConfig(ctx, configID); err != nil {
+		return err
+	}
+
+	for {
+		_, err := a.ExocomputeConfig(ctx, configID)
+		if errors.Is(err, graphql.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		a.log.Printf(log.Debug, "Waiting for exocompute config %s to be removed", configID)
+		select {
+		case <-time.After(poll):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // ExocomputeHostAccount returns the exocompute host cloud account ID for the
 // specified application cloud account.
 func (a API) ExocomputeHostAccount(ctx context.Context, appID IdentityFunc) (uuid.UUID, error) {
@@ -23,13 +23,20 @@ package aws
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testsetup"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
 )
 
 // TestAwsExocompute verifies that the SDK can perform basic Exocompute
@@ -162,8 +169,8 @@ func TestAwsExocompute(t *testing.T) {
 	// Verify that the exocompute config has been updated to use subnet 1 & 2 from the test account.
 	validateConfig(exoID, 1, 2)
 
-	// Remove the exocompute config.
-	err = awsClient.RemoveExocomputeConfig(ctx, exoID)
+	// Remove the exocompute config and wait for the removal to complete.
+	err = awsClient.RemoveExocomputeConfigAndWait(ctx, exoID, 10*time.Second)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -198,3 +205,47 @@ func TestAwsExocompute(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestWaitForExocomputeConfig(t *testing.T) {
+	gqlClient, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	awsClient := Wrap(&polaris.Client{GQL: gqlClient})
+
+	// Respond with the exocompute config, using the next health status from
+	// the statuses slice for each request.
+	configID := uuid.MustParse("f0f1f2f3-0000-4000-8000-000000000001")
+	var statuses []HealthCheckStatus
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		fmt.Fprintf(w, `{"data": {"result": [{"exocomputeConfigs": [{"configUuid": "%s", "region": "US_EAST_1",
+			"healthCheckStatus": {"status": %q, "failureReason": %q}}]}]}}`, configID, status.Status, status.FailureReason)
+	})
+	defer srv.Shutdown(context.Background())
+
+	statuses = []HealthCheckStatus{{Status: "PENDING"}, {Status: "PENDING"}, {Status: "CONNECTED"}}
+	if err := awsClient.WaitForExocomputeConfig(context.Background(), configID, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses = []HealthCheckStatus{{Status: "PENDING"}, {Status: "DISCONNECTED", FailureReason: "subnet not found"}}
+	err := awsClient.WaitForExocomputeConfig(context.Background(), configID, time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "subnet not found") {
+		t.Errorf("expected health check failure with reason, got: %v", err)
+	}
+
+	// A disconnected cluster without a failure reason must not be waited on
+	// forever.
+	statuses = []HealthCheckStatus{{Status: "DISCONNECTED"}}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := awsClient.WaitForExocomputeConfig(ctx, configID, time.Millisecond); err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected health check failure, got: %v", err)
+	}
+
+	_, err = awsClient.ExocomputeConfig(context.Background(), uuid.New())
+	if !errors.Is(err, graphql.ErrNotFound) {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+}
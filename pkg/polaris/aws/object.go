@@ -0,0 +1,211 @@
+// Copyright 2021 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/aws"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// ObjectType represents the type of an AWS object which can be protected by
+// RSC.
+type ObjectType string
+
+const (
+	ObjectTypeDynamoDBTable ObjectType = "DYNAMODB_TABLE"
+	ObjectTypeEBSVolume     ObjectType = "EBS_VOLUME"
+	ObjectTypeEC2Instance   ObjectType = "EC2_INSTANCE"
+	ObjectTypeRDSInstance   ObjectType = "RDS_INSTANCE"
+	ObjectTypeS3Bucket      ObjectType = "S3_BUCKET"
+)
+
+// ProtectedObject represents an AWS object and its protection status in RSC.
+// LastSnapshot is the zero time if the object doesn't have any snapshots.
+type ProtectedObject struct {
+	ID            uuid.UUID // Rubrik object ID.
+	NativeID      string    // AWS object ID.
+	Name          string
+	ObjectType    ObjectType
+	Region        string
+	SLAAssignment core.SLAAssignment
+	SLADomain     core.SLADomain // Effective SLA domain.
+	LastSnapshot  time.Time
+}
+
+// Protected returns true if the object is protected by an SLA domain.
+func (o ProtectedObject) Protected() bool {
+	return o.SLADomain.Protects()
+}
+
+// ProtectedObjects returns all objects of the specified object type for the
+// account with the specified id. Both protected and unprotected objects are
+// returned, use ProtectedObject.Protected to tell them apart.
+func (a API) ProtectedObjects(ctx context.Context, id IdentityFunc, objectType ObjectType) ([]ProtectedObject, error) {
+	a.log.Print(log.Trace)
+
+	accountID, err := a.toCloudAccountID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cloud account id: %s", err)
+	}
+
+	var objects []ProtectedObject
+	switch objectType {
+	case ObjectTypeDynamoDBTable:
+		tables, err := aws.Wrap(a.client).NativeDynamoDBTables(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dynamodb tables: %s", err)
+		}
+		for _, table := range tables {
+			objects = append(objects, ProtectedObject{
+				ID:            table.ID,
+				NativeID:      table.ARN,
+				Name:          table.Name,
+				ObjectType:    ObjectTypeDynamoDBTable,
+				Region:        aws.FormatRegion(table.Region),
+				SLAAssignment: table.Assignment,
+				SLADomain:     table.Effective,
+				LastSnapshot:  table.NewestSnapshot.Time(),
+			})
+		}
+	case ObjectTypeEBSVolume:
+		volumes, err := aws.Wrap(a.client).NativeEBSVolumes(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ebs volumes: %s", err)
+		}
+		for _, volume := range volumes {
+			objects = append(objects, ProtectedObject{
+				ID:            volume.ID,
+				NativeID:      volume.NativeID,
+				Name:          volume.Name,
+				ObjectType:    ObjectTypeEBSVolume,
+				Region:        aws.FormatRegion(volume.Region),
+				SLAAssignment: volume.Assignment,
+				SLADomain:     volume.Effective,
+				LastSnapshot:  volume.NewestSnapshot.Time(),
+			})
+		}
+	case ObjectTypeEC2Instance:
+		instances, err := aws.Wrap(a.client).NativeEC2Instances(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ec2 instances: %s", err)
+		}
+		for _, instance := range instances {
+			objects = append(objects, ProtectedObject{
+				ID:            instance.ID,
+				NativeID:      instance.NativeID,
+				Name:          instance.Name,
+				ObjectType:    ObjectTypeEC2Instance,
+				Region:        aws.FormatRegion(instance.Region),
+				SLAAssignment: instance.Assignment,
+				SLADomain:     instance.Effective,
+				LastSnapshot:  instance.NewestSnapshot.Time(),
+			})
+		}
+	case ObjectTypeRDSInstance:
+		instances, err := aws.Wrap(a.client).NativeRDSInstances(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rds instances: %s", err)
+		}
+		for _, instance := range instances {
+			objects = append(objects, ProtectedObject{
+				ID:            instance.ID,
+				NativeID:      instance.NativeID,
+				Name:          instance.Name,
+				ObjectType:    ObjectTypeRDSInstance,
+				Region:        aws.FormatRegion(instance.Region),
+				SLAAssignment: instance.Assignment,
+				SLADomain:     instance.Effective,
+				LastSnapshot:  instance.NewestSnapshot.Time(),
+			})
+		}
+	case ObjectTypeS3Bucket:
+		buckets, err := aws.Wrap(a.client).NativeS3Buckets(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get s3 buckets: %s", err)
+		}
+		for _, bucket := range buckets {
+			objects = append(objects, ProtectedObject{
+				ID:            bucket.ID,
+				NativeID:      bucket.NativeID,
+				Name:          bucket.Name,
+				ObjectType:    ObjectTypeS3Bucket,
+				Region:        aws.FormatRegion(bucket.Region),
+				SLAAssignment: bucket.Assignment,
+				SLADomain:     bucket.Effective,
+				LastSnapshot:  bucket.NewestSnapshot.Time(),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("invalid object type: %s", objectType)
+	}
+
+	return objects, nil
+}
+
+// DynamoDBTables returns all DynamoDB tables for the account with the
+// specified id. Both protected and unprotected tables are returned. The
+// NativeID of a table is the table ARN.
+func (a API) DynamoDBTables(ctx context.Context, id IdentityFunc) ([]ProtectedObject, error) {
+	a.log.Print(log.Trace)
+
+	return a.ProtectedObjects(ctx, id, ObjectTypeDynamoDBTable)
+}
+
+// RDSInstances returns all RDS instances for the account with the specified
+// id. Both protected and unprotected instances are returned. The NativeID of
+// an instance is the DBI resource ID.
+func (a API) RDSInstances(ctx context.Context, id IdentityFunc) ([]ProtectedObject, error) {
+	a.log.Print(log.Trace)
+
+	return a.ProtectedObjects(ctx, id, ObjectTypeRDSInstance)
+}
+
+// S3Buckets returns all S3 buckets for the account with the specified id. Both
+// protected and unprotected buckets are returned. Use AssignSLADomain with an
+// SLA domain holding an S3 object specific configuration to protect them.
+func (a API) S3Buckets(ctx context.Context, id IdentityFunc) ([]ProtectedObject, error) {
+	a.log.Print(log.Trace)
+
+	return a.ProtectedObjects(ctx, id, ObjectTypeS3Bucket)
+}
+
+// AssignSLADomain assigns the SLA domain with the specified id to the objects
+// with the specified ids. See core.API.AssignSLADomain for details.
+func (a API) AssignSLADomain(ctx context.Context, slaDomainID uuid.UUID, objectIDs []uuid.UUID, applyToExisting bool) error {
+	a.log.Print(log.Trace)
+
+	return core.Wrap(a.client).AssignSLADomain(ctx, slaDomainID, objectIDs, applyToExisting)
+}
+
+// UnassignSLADomain removes the SLA domain directly assigned to the objects
+// with the specified ids, letting them inherit the SLA domain of their account.
+// See core.API.UnassignSLADomain for details.
+func (a API) UnassignSLADomain(ctx context.Context, objectIDs []uuid.UUID) error {
+	a.log.Print(log.Trace)
+
+	return core.Wrap(a.client).UnassignSLADomain(ctx, objectIDs)
+}
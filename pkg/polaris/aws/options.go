@@ -29,21 +29,45 @@ import (
 type options struct {
 	name    string
 	regions []aws.Region
+	tags    map[string]string
 }
 
 // OptionFunc gives the value passed to the function creating the OptionFunc
 // to the specified options instance.
 type OptionFunc func(ctx context.Context, opts *options) error
 
-// Name returns an OptionFunc that gives the specified name to the options
+// WithName returns an OptionFunc that gives the specified name to the options
 // instance.
-func Name(name string) OptionFunc {
+func WithName(name string) OptionFunc {
 	return func(ctx context.Context, opts *options) error {
 		opts.name = name
 		return nil
 	}
 }
 
+// Name is an alias for WithName.
+func Name(name string) OptionFunc {
+	return WithName(name)
+}
+
+// WithTags returns an OptionFunc that gives the specified tags to the options
+// instance. The tags are applied to the CloudFormation stack created when
+// adding an account using a CloudFormation stack. Adding an account without a
+// CloudFormation stack fails if tags are given, since there is nothing to
+// apply them to. Updating an account fails if tags are given. Multiple WithTags
+// options are merged.
+func WithTags(tags map[string]string) OptionFunc {
+	return func(ctx context.Context, opts *options) error {
+		if opts.tags == nil {
+			opts.tags = make(map[string]string, len(tags))
+		}
+		for key, value := range tags {
+			opts.tags[key] = value
+		}
+		return nil
+	}
+}
+
 // Region returns an OptionFunc that gives the specified region to the options
 // instance.
 func Region(region string) OptionFunc {
@@ -0,0 +1,123 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// OrganizationAccount represents an account in an AWS Organization and its
+// onboarding state in RSC. ID is the RSC cloud account ID, it's uuid.Nil if
+// the account hasn't been onboarded.
+type OrganizationAccount struct {
+	NativeID  string // AWS account ID.
+	Name      string
+	Email     string
+	Status    string // AWS account status, e.g. ACTIVE or SUSPENDED.
+	ID        uuid.UUID
+	Onboarded bool
+}
+
+// DiscoverOrganizationAccounts returns all accounts of the AWS Organization
+// managed by the account of the specified management role. The role is assumed
+// using the default profile and must be allowed to list the accounts of the
+// organization. Each account returned is marked as onboarded if it's already
+// added to RSC.
+func (a API) DiscoverOrganizationAccounts(ctx context.Context, managementRoleARN string) ([]OrganizationAccount, error) {
+	a.log.Print(log.Trace)
+
+	partition, err := validateRoleARN(managementRoleARN)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load default profile: %s", err)
+	}
+	if config.Region == "" {
+		config.Region = partitionRegion(partition)
+	}
+	stsClient := sts.NewFromConfig(config)
+	config.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, managementRoleARN))
+
+	orgAccounts, err := listOrganizationAccounts(ctx, organizations.NewFromConfig(config))
+	if err != nil {
+		return nil, err
+	}
+
+	rscAccounts, err := a.Accounts(ctx, core.FeatureAll, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rsc accounts: %s", err)
+	}
+
+	return organizationAccounts(orgAccounts, rscAccounts), nil
+}
+
+// listOrganizationAccounts returns all accounts of the AWS Organization.
+func listOrganizationAccounts(ctx context.Context, client organizations.ListAccountsAPIClient) ([]types.Account, error) {
+	var accounts []types.Account
+	paginator := organizations.NewListAccountsPaginator(client, &organizations.ListAccountsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organization accounts: %s", err)
+		}
+		accounts = append(accounts, page.Accounts...)
+	}
+
+	return accounts, nil
+}
+
+// organizationAccounts pairs the accounts of the AWS Organization with the
+// accounts already onboarded in RSC.
+func organizationAccounts(orgAccounts []types.Account, rscAccounts []CloudAccount) []OrganizationAccount {
+	onboarded := make(map[string]uuid.UUID, len(rscAccounts))
+	for _, account := range rscAccounts {
+		onboarded[account.NativeID] = account.ID
+	}
+
+	accounts := make([]OrganizationAccount, 0, len(orgAccounts))
+	for _, orgAccount := range orgAccounts {
+		account := OrganizationAccount{
+			NativeID: aws.ToString(orgAccount.Id),
+			Name:     aws.ToString(orgAccount.Name),
+			Email:    aws.ToString(orgAccount.Email),
+			Status:   string(orgAccount.Status),
+		}
+		account.ID, account.Onboarded = onboarded[account.NativeID]
+		accounts = append(accounts, account)
+	}
+
+	return accounts
+}
@@ -136,7 +136,7 @@ func (a API) UpdatePermissions(ctx context.Context, account AccountFunc, feature
 	}
 	stackID := u.Query().Get("stackId")
 
-	err = awsUpdateStack(ctx, a.client.Log(), *config.config, stackID, tmplURL)
+	err = awsUpdateStack(ctx, a.client.Log(), *config.config, stackID, tmplURL, nil)
 	if err != nil {
 		return fmt.Errorf("failed to update CloudFormation stack: %v", err)
 	}
@@ -0,0 +1,98 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/aws"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// RecoverEC2Options holds the options for recovering an EC2 instance snapshot
+// to a new instance. Account is the destination account and Region the
+// destination region, which can differ from the account and region of the
+// original instance. The region must be valid for the cloud of the destination
+// account, e.g. a gov region requires a gov account. InstanceType, e.g.
+// m5.large, and SubnetID are required since RSC doesn't default them to the
+// values of the original instance.
+type RecoverEC2Options struct {
+	Account          IdentityFunc
+	Region           string
+	InstanceName     string
+	InstanceType     string
+	SubnetID         string
+	SecurityGroupIDs []string
+	CopyTags         bool // Copy the tags of the original instance.
+	PowerOn          bool // Power on the instance after the recovery.
+}
+
+// RecoverEC2Instance starts a job recovering the EC2 instance snapshot with the
+// specified id to a new instance. Returns the RSC task chain id of the
+// recovery job, which can be passed to core.API.WaitForTaskChain to track the
+// status of the recovery.
+func (a API) RecoverEC2Instance(ctx context.Context, snapshotID uuid.UUID, opts RecoverEC2Options) (uuid.UUID, error) {
+	a.log.Print(log.Trace)
+
+	if opts.Account == nil {
+		return uuid.Nil, errors.New("destination account is not allowed to be nil")
+	}
+	if opts.Region == "" {
+		return uuid.Nil, errors.New("destination region is not allowed to be empty")
+	}
+	if opts.InstanceType == "" {
+		return uuid.Nil, errors.New("instance type is not allowed to be empty")
+	}
+	if opts.SubnetID == "" {
+		return uuid.Nil, errors.New("subnet id is not allowed to be empty")
+	}
+	region, err := aws.ParseRegion(opts.Region)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid destination region %q: %s", opts.Region, err)
+	}
+	account, err := a.Account(ctx, opts.Account, core.FeatureAll)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get destination account: %s", err)
+	}
+	if err := aws.ValidateRegions(aws.Cloud(account.Cloud), []aws.Region{region}); err != nil {
+		return uuid.Nil, fmt.Errorf("invalid destination region %q for account %s: %s", opts.Region, account.ID, err)
+	}
+
+	jobID, err := core.Wrap(a.client).RestoreSnapshot(ctx, snapshotID, aws.EC2InstanceExportTarget{
+		AccountID:        account.ID,
+		Region:           region,
+		InstanceName:     opts.InstanceName,
+		InstanceType:     opts.InstanceType,
+		SubnetID:         opts.SubnetID,
+		SecurityGroupIDs: opts.SecurityGroupIDs,
+		CopyTags:         opts.CopyTags,
+		PowerOn:          opts.PowerOn,
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to recover ec2 instance: %s", err)
+	}
+
+	return jobID, nil
+}
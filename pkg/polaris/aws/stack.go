@@ -88,9 +88,10 @@ func awsWaitForStack(ctx context.Context, config aws.Config, stackName string) (
 }
 
 // awsUpdateStack creates the stack if it doesn't exist, otherwise it's
-// updated.
-func awsUpdateStack(ctx context.Context, logger log.Logger, config aws.Config, stackName, templateURL string) error {
+// updated. When tags is nil, the tags of an existing stack are kept.
+func awsUpdateStack(ctx context.Context, logger log.Logger, config aws.Config, stackName, templateURL string, tags map[string]string) error {
 	client := cloudformation.NewFromConfig(config)
+	stackTags := toStackTags(tags)
 
 	logger.Printf(log.Debug, "Accessing CloudFormation stack: %v", stackName)
 	exist, err := awsStackExist(ctx, config, stackName)
@@ -104,6 +105,7 @@ func awsUpdateStack(ctx context.Context, logger log.Logger, config aws.Config, s
 			StackName:    &stackName,
 			TemplateURL:  &templateURL,
 			Capabilities: []types.Capability{types.CapabilityCapabilityIam},
+			Tags:         stackTags,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to update CloudFormation stack %q in region %q: %v", stackName, config.Region, err)
@@ -122,6 +124,7 @@ func awsUpdateStack(ctx context.Context, logger log.Logger, config aws.Config, s
 			StackName:    &stackName,
 			TemplateURL:  &templateURL,
 			Capabilities: []types.Capability{types.CapabilityCapabilityIam},
+			Tags:         stackTags,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create CloudFormation stack %q in region %q: %v", stackName, config.Region, err)
@@ -139,6 +142,21 @@ func awsUpdateStack(ctx context.Context, logger log.Logger, config aws.Config, s
 	return nil
 }
 
+// toStackTags converts the tags to CloudFormation stack tags. Returns nil if
+// tags is nil.
+func toStackTags(tags map[string]string) []types.Tag {
+	if tags == nil {
+		return nil
+	}
+
+	stackTags := make([]types.Tag, 0, len(tags))
+	for key, value := range tags {
+		stackTags = append(stackTags, types.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+
+	return stackTags
+}
+
 // awsDeleteStack deletes the stack.
 func awsDeleteStack(ctx context.Context, logger log.Logger, config aws.Config, stackName string) error {
 	client := cloudformation.NewFromConfig(config)
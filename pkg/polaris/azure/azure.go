@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
@@ -75,6 +76,37 @@ type CloudAccount struct {
 	Features     []Feature
 }
 
+var _ core.CloudAccount = CloudAccount{}
+
+// AccountID returns the RSC cloud account ID.
+func (c CloudAccount) AccountID() uuid.UUID {
+	return c.ID
+}
+
+// AccountNativeID returns the Azure subscription ID.
+func (c CloudAccount) AccountNativeID() string {
+	return c.NativeID.String()
+}
+
+// AccountName returns the name of the cloud account.
+func (c CloudAccount) AccountName() string {
+	return c.Name
+}
+
+// CloudVendor returns core.CloudVendorAzure.
+func (c CloudAccount) CloudVendor() core.CloudVendor {
+	return core.CloudVendorAzure
+}
+
+// AccountFeatures returns the RSC features of the cloud account.
+func (c CloudAccount) AccountFeatures() []core.Feature {
+	features := make([]core.Feature, 0, len(c.Features))
+	for _, feature := range c.Features {
+		features = append(features, feature.Feature)
+	}
+	return features
+}
+
 // Feature returns the specified feature from the CloudAccount's features.
 func (c CloudAccount) Feature(feature core.Feature) (Feature, bool) {
 	for _, f := range c.Features {
@@ -86,12 +118,34 @@ func (c CloudAccount) Feature(feature core.Feature) (Feature, bool) {
 	return Feature{}, false
 }
 
+// FeatureMap returns the regions of the CloudAccount's features, keyed by
+// feature name.
+func (c CloudAccount) FeatureMap() map[string][]string {
+	features := make(map[string][]string, len(c.Features))
+	for _, f := range c.Features {
+		features[f.Name] = slices.Clone(f.Regions)
+	}
+
+	return features
+}
+
+// FeatureStatuses returns the status of the CloudAccount's features, keyed by
+// feature name.
+func (c CloudAccount) FeatureStatuses() map[string]core.FeatureStatus {
+	statuses := make(map[string]core.FeatureStatus, len(c.Features))
+	for _, f := range c.Features {
+		statuses[f.Name] = f.Status
+	}
+
+	return statuses
+}
+
 // Feature for Azure cloud account.
 type Feature struct {
 	core.Feature
 	ResourceGroup               FeatureResourceGroup
 	Regions                     []string
-	Status                      core.Status
+	Status                      core.FeatureStatus
 	UserAssignedManagedIdentity FeatureUserAssignedManagedIdentity
 }
 
@@ -374,6 +428,9 @@ func (a API) AddSubscription(ctx context.Context, subscription SubscriptionFunc,
 	if subscription == nil {
 		return uuid.Nil, errors.New("subscription is not allowed to be nil")
 	}
+	if err := core.ValidateFeatures(feature); err != nil {
+		return uuid.Nil, err
+	}
 	config, err := subscription(ctx)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("failed to lookup subscription: %v", err)
@@ -388,6 +445,31 @@ func (a API) AddSubscription(ctx context.Context, subscription SubscriptionFunc,
 	if options.name != "" {
 		config.name = options.name
 	}
+	if options.cloud == "" {
+		options.cloud = azure.PublicCloud
+	}
+	if err := azure.ValidateRegions(options.cloud, options.regions); err != nil {
+		return uuid.Nil, err
+	}
+	if options.resourceGroup != nil {
+		if err := azure.ValidateRegions(options.cloud, []azure.Region{options.resourceGroup.Region.Region}); err != nil {
+			return uuid.Nil, fmt.Errorf("invalid resource group region: %s", err)
+		}
+	}
+	if err := options.validateResourceGroupRegion(); err != nil {
+		return uuid.Nil, err
+	}
+	if feature.Equal(core.FeatureCloudNativeArchivalEncryption) {
+		if options.featureSpecificInfo == nil || options.featureSpecificInfo.UserAssignedManagedIdentity == nil {
+			return uuid.Nil, errors.New("archival encryption requires a managed identity")
+		}
+		if err := options.featureSpecificInfo.UserAssignedManagedIdentity.Validate(); err != nil {
+			return uuid.Nil, fmt.Errorf("invalid managed identity: %s", err)
+		}
+	}
+	if err := options.applyTags(); err != nil {
+		return uuid.Nil, err
+	}
 
 	// If there already is an RSC cloud account for the given Azure
 	// subscription, we use the same name when adding the new feature.
@@ -398,6 +480,9 @@ func (a API) AddSubscription(ctx context.Context, subscription SubscriptionFunc,
 	if err != nil && !errors.Is(err, graphql.ErrNotFound) {
 		return uuid.Nil, fmt.Errorf("failed to get subscription: %v", err)
 	}
+	if err := core.ValidateFeatureParents([]core.Feature{feature}, account.AccountFeatures()); err != nil {
+		return uuid.Nil, err
+	}
 
 	perms, err := azure.Wrap(a.client).CloudAccountPermissionConfig(ctx, feature)
 	if err != nil {
@@ -412,7 +497,7 @@ func (a API) AddSubscription(ctx context.Context, subscription SubscriptionFunc,
 		FeatureSpecificInfo: options.featureSpecificInfo,
 	}
 
-	_, err = azure.Wrap(a.client).AddCloudAccountWithoutOAuth(ctx, azure.PublicCloud, config.id, cloudAccountFeature,
+	_, err = azure.Wrap(a.client).AddCloudAccountWithoutOAuth(ctx, options.cloud, config.id, cloudAccountFeature,
 		config.name, config.tenantDomain, options.regions)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("failed to add subscription: %v", err)
@@ -502,12 +587,19 @@ func (a API) disableFeature(ctx context.Context, account CloudAccount, feature c
 func (a API) UpdateSubscription(ctx context.Context, id IdentityFunc, feature core.Feature, opts ...OptionFunc) error {
 	a.log.Print(log.Trace)
 
+	if err := core.ValidateFeatures(feature); err != nil {
+		return err
+	}
+
 	var options options
 	for _, option := range opts {
 		if err := option(ctx, &options); err != nil {
 			return fmt.Errorf("failed to lookup option: %v", err)
 		}
 	}
+	if len(options.tags) > 0 {
+		return errors.New("tags cannot be updated")
+	}
 	if options.name == "" && len(options.regions) == 0 {
 		return errors.New("nothing to update")
 	}
@@ -565,6 +657,83 @@ func (a API) UpdateSubscription(ctx context.Context, id IdentityFunc, feature co
 	return nil
 }
 
+// EnsureSubscription makes sure the subscription is onboarded with the
+// specified feature. If the subscription doesn't have the feature, the
+// subscription is added with the feature. If the subscription has the
+// feature, the regions and name of the subscription are updated to match the
+// desired state given by the options. Returns the RSC cloud account ID and
+// true if a change was made.
+func (a API) EnsureSubscription(ctx context.Context, subscription SubscriptionFunc, feature core.Feature, opts ...OptionFunc) (uuid.UUID, bool, error) {
+	a.log.Print(log.Trace)
+
+	if subscription == nil {
+		return uuid.Nil, false, errors.New("subscription is not allowed to be nil")
+	}
+	config, err := subscription(ctx)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("failed to lookup subscription: %v", err)
+	}
+
+	var options options
+	for _, option := range opts {
+		if err := option(ctx, &options); err != nil {
+			return uuid.Nil, false, fmt.Errorf("failed to lookup option: %v", err)
+		}
+	}
+
+	existing, err := a.Subscription(ctx, SubscriptionID(config.id), feature)
+	if err != nil && !errors.Is(err, graphql.ErrNotFound) {
+		return uuid.Nil, false, fmt.Errorf("failed to get subscription: %v", err)
+	}
+	existingFeature, ok := existing.Feature(feature)
+	if err != nil || !ok || existingFeature.Status == core.StatusDisabled {
+		id, err := a.AddSubscription(ctx, subscription, feature, opts...)
+		if err != nil {
+			return uuid.Nil, false, err
+		}
+		return id, true, nil
+	}
+
+	var updates []OptionFunc
+	if options.name != "" && options.name != existing.Name {
+		updates = append(updates, Name(options.name))
+	}
+	if len(options.regions) > 0 && !sameRegions(existingFeature.Regions, options.regions) {
+		names := make([]string, 0, len(options.regions))
+		for _, region := range options.regions {
+			names = append(names, region.Name())
+		}
+		updates = append(updates, Regions(names...))
+	}
+	if len(updates) == 0 {
+		return existing.ID, false, nil
+	}
+	if err := a.UpdateSubscription(ctx, CloudAccountID(existing.ID), feature, updates...); err != nil {
+		return uuid.Nil, false, err
+	}
+
+	return existing.ID, true, nil
+}
+
+// sameRegions returns true if the region names and the regions hold the same
+// set of regions.
+func sameRegions(names []string, regions []azure.Region) bool {
+	set := make(map[azure.Region]struct{}, len(regions))
+	for _, region := range regions {
+		set[region] = struct{}{}
+	}
+	other := make(map[azure.Region]struct{}, len(names))
+	for _, name := range names {
+		region := azure.RegionFromName(name)
+		if _, ok := set[region]; !ok {
+			return false
+		}
+		other[region] = struct{}{}
+	}
+
+	return len(set) == len(other)
+}
+
 // AddServicePrincipal adds the service principal for the app. If shouldReplace
 // is true and the app already has a service principal, it will be replaced.
 // Note that it's not possible to remove a service principal once it has been
@@ -704,3 +873,22 @@ func toTenants(rawTenants []azure.CloudAccountTenant) []CloudAccountTenant {
 
 	return tenants
 }
+
+// WaitForFeatureStatus blocks until the feature of the subscription with the
+// specified id reaches the specified status. If the feature reaches a terminal
+// status other than the specified status, an error is returned.
+func (a API) WaitForFeatureStatus(ctx context.Context, id IdentityFunc, feature core.Feature, status core.FeatureStatus) error {
+	a.log.Print(log.Trace)
+
+	return core.Wrap(a.client).WaitForFeatureStatus(ctx, status, 10*time.Second, func(ctx context.Context) (core.FeatureStatus, error) {
+		subscription, err := a.Subscription(ctx, id, feature)
+		if err != nil {
+			return "", err
+		}
+		f, ok := subscription.Feature(feature)
+		if !ok {
+			return "", fmt.Errorf("feature %s %w", feature, graphql.ErrNotFound)
+		}
+		return f.Status, nil
+	})
+}
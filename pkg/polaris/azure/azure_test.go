@@ -26,12 +26,14 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"net/http"
 	"os"
 	"reflect"
 	"slices"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testsetup"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
@@ -153,7 +155,7 @@ func TestAzureSubscriptionAddAndRemove(t *testing.T) {
 	if regions := feature.Regions; !slices.Equal(regions, testSubscription.CloudNativeProtection.Regions) {
 		t.Fatalf("invalid feature regions: %v", regions)
 	}
-	if status := feature.Status; status != "CONNECTED" {
+	if status := feature.Status; status != core.StatusConnected {
 		t.Fatalf("invalid feature status: %v", status)
 	}
 	if name := feature.ResourceGroup.Name; name != testSubscription.CloudNativeProtection.ResourceGroupName {
@@ -275,7 +277,7 @@ func TestAzureArchivalSubscriptionAddAndRemove(t *testing.T) {
 	if regions := feature.Regions; !slices.Equal(regions, testSubscription.Archival.Regions) {
 		t.Fatalf("invalid feature regions: %v", regions)
 	}
-	if status := feature.Status; status != "CONNECTED" {
+	if status := feature.Status; status != core.StatusConnected {
 		t.Fatalf("invalid feature status: %v", status)
 	}
 	if name := feature.ResourceGroup.Name; name != testSubscription.Archival.ResourceGroupName {
@@ -409,7 +411,7 @@ func TestAzureArchivalEncryptionSubscriptionAddAndRemove(t *testing.T) {
 	if regions := feature.Regions; !slices.Equal(regions, testSubscription.Archival.Regions) {
 		t.Fatalf("invalid feature regions: %v", regions)
 	}
-	if status := feature.Status; status != "CONNECTED" {
+	if status := feature.Status; status != core.StatusConnected {
 		t.Fatalf("invalid feature status: %v", status)
 	}
 	if name := feature.ResourceGroup.Name; name != testSubscription.Archival.ResourceGroupName {
@@ -494,7 +496,7 @@ func TestToSubscription(t *testing.T) {
 			Tags:     map[string]string{},
 		},
 		Regions: []string{"eastus", "westus"},
-		Status:  "MISSING_PERMISSIONS",
+		Status:  core.StatusMissingPermissions,
 	}, {
 		Feature: core.Feature{Name: "EXOCOMPUTE"},
 		ResourceGroup: FeatureResourceGroup{
@@ -504,7 +506,7 @@ func TestToSubscription(t *testing.T) {
 			Tags:     map[string]string{},
 		},
 		Regions: []string{"westus"},
-		Status:  "CONNECTED",
+		Status:  core.StatusConnected,
 	}}) {
 		t.Errorf("invalid features: %v", subs[0].Features)
 	}
@@ -534,7 +536,7 @@ func TestToSubscription(t *testing.T) {
 			Tags:     map[string]string{},
 		},
 		Regions: []string{"westus2"},
-		Status:  "MISSING_PERMISSIONS",
+		Status:  core.StatusMissingPermissions,
 	}, {
 		Feature: core.Feature{Name: "EXOCOMPUTE"},
 		ResourceGroup: FeatureResourceGroup{
@@ -544,7 +546,7 @@ func TestToSubscription(t *testing.T) {
 			Tags:     map[string]string{},
 		},
 		Regions: []string{},
-		Status:  "CONNECTED",
+		Status:  core.StatusConnected,
 	}}) {
 		t.Errorf("invalid features: %v", subs[1].Features)
 	}
@@ -576,7 +578,7 @@ func TestToSubscription(t *testing.T) {
 			},
 		},
 		Regions: []string{"westus"},
-		Status:  "MISSING_PERMISSIONS",
+		Status:  core.StatusMissingPermissions,
 	}, {
 		Feature: core.Feature{Name: "EXOCOMPUTE"},
 		ResourceGroup: FeatureResourceGroup{
@@ -586,7 +588,7 @@ func TestToSubscription(t *testing.T) {
 			Tags:     map[string]string{},
 		},
 		Regions: []string{"westus"},
-		Status:  "MISSING_PERMISSIONS",
+		Status:  core.StatusMissingPermissions,
 	}}) {
 		t.Errorf("invalid features: %v", subs[2].Features)
 	}
@@ -661,3 +663,145 @@ func allAzureCloudAccountTenantsResponse() ([]azure.CloudAccountTenant, error) {
 
 	return payload.Data.Result, nil
 }
+
+func TestSameRegions(t *testing.T) {
+	if !sameRegions([]string{"eastus2", "westus"}, []azure.Region{azure.RegionWestUS, azure.RegionEastUS2}) {
+		t.Error("regions should be the same")
+	}
+	if sameRegions([]string{"eastus2"}, []azure.Region{azure.RegionEastUS2, azure.RegionWestUS}) {
+		t.Error("regions should differ")
+	}
+	if sameRegions([]string{"eastus2", "westus"}, []azure.Region{azure.RegionEastUS2}) {
+		t.Error("regions should differ")
+	}
+}
+
+func TestOptionsApplyTags(t *testing.T) {
+	var opts options
+	for _, option := range []OptionFunc{
+		WithTags(map[string]string{"owner": "ops"}),
+		ResourceGroup("rg", "eastus", map[string]string{"env": "dev", "owner": "dev"}),
+	} {
+		if err := option(context.Background(), &opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := opts.applyTags(); err != nil {
+		t.Fatal(err)
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range opts.resourceGroup.TagList.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	if !reflect.DeepEqual(tags, map[string]string{"env": "dev", "owner": "ops"}) {
+		t.Errorf("invalid tags: %v", tags)
+	}
+
+	opts = options{}
+	if err := WithTags(map[string]string{"owner": "ops"})(context.Background(), &opts); err != nil {
+		t.Fatal(err)
+	}
+	if err := opts.applyTags(); err == nil {
+		t.Error("tags without a resource group should fail")
+	}
+}
+
+func TestOptionsValidateResourceGroupRegion(t *testing.T) {
+	applyOptions := func(opts ...OptionFunc) options {
+		var options options
+		for _, option := range opts {
+			if err := option(context.Background(), &options); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return options
+	}
+
+	opts := applyOptions(Regions("eastus", "westus"), ResourceGroup("rg", "westus", nil))
+	if err := opts.validateResourceGroupRegion(); err != nil {
+		t.Error(err)
+	}
+
+	opts = applyOptions(ResourceGroup("rg", "westus", nil))
+	if err := opts.validateResourceGroupRegion(); err != nil {
+		t.Error(err)
+	}
+
+	opts = applyOptions(Regions("eastus"), ResourceGroup("rg", "", nil))
+	if err := opts.validateResourceGroupRegion(); err != nil {
+		t.Error(err)
+	}
+
+	opts = applyOptions(Regions("eastus", "eastus2"), ResourceGroup("rg", "westus", nil))
+	if err := opts.validateResourceGroupRegion(); err == nil {
+		t.Error("resource group region outside of the feature regions should fail")
+	}
+}
+
+func TestRecoverVM(t *testing.T) {
+	gqlClient, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	azureClient := Wrap(&polaris.Client{GQL: gqlClient})
+
+	// Respond with the job id, echoing the destination region and vm size of
+	// the request in the error field if they don't match.
+	jobID := uuid.MustParse("b8b2c3d4-0000-4000-8000-000000000001")
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		var payload struct {
+			Variables struct {
+				SubscriptionID uuid.UUID `json:"subscriptionId"`
+				Region         string    `json:"region"`
+				VMSize         string    `json:"vmSize"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if v := payload.Variables; v.Region != "EAST_US" || v.VMSize != "Standard_B2s" || v.SubscriptionID == uuid.Nil {
+			fmt.Fprintf(w, `{"data": {"result": {"error": "unexpected variables: %s, %s"}}}`, v.Region, v.VMSize)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"result": {"error": "", "jobId": "%s"}}}`, jobID)
+	})
+	defer srv.Shutdown(context.Background())
+
+	opts := RecoverVMOptions{
+		Subscription:  CloudAccountID(uuid.MustParse("c0b1a2f3-0000-4000-8000-000000000002")),
+		Region:        "eastus",
+		ResourceGroup: "recovery-rg",
+		VMSize:        "Standard_B2s",
+		SubnetID:      "/subscriptions/x/resourceGroups/recovery-rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default",
+	}
+	snapshotID := uuid.MustParse("d0e1f2a3-0000-4000-8000-000000000003")
+	id, err := azureClient.RecoverVM(context.Background(), snapshotID, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != jobID {
+		t.Errorf("invalid job id: %s", id)
+	}
+
+	invalid := opts
+	invalid.Region = "atlantis"
+	if _, err := azureClient.RecoverVM(context.Background(), snapshotID, invalid); err == nil {
+		t.Error("invalid region should fail")
+	}
+	invalid = opts
+	invalid.ResourceGroup = ""
+	if _, err := azureClient.RecoverVM(context.Background(), snapshotID, invalid); err == nil {
+		t.Error("empty resource group should fail")
+	}
+}
+
+func TestUpdateSubscriptionTags(t *testing.T) {
+	gqlClient, _ := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	azureClient := Wrap(&polaris.Client{GQL: gqlClient})
+
+	// Tags can't be updated, the update fails before any request is made.
+	err := azureClient.UpdateSubscription(context.Background(), CloudAccountID(uuid.New()),
+		core.FeatureCloudNativeProtection, Name("test"), WithTags(map[string]string{"owner": "ops"}))
+	if err == nil || err.Error() != "tags cannot be updated" {
+		t.Errorf("expected tags error, got: %v", err)
+	}
+}
@@ -22,6 +22,7 @@ package azure
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -81,6 +82,27 @@ func ManagedWithOverlayNetwork(region, subnetID, podOverlayNetworkCIDR string) E
 	}
 }
 
+// ManagedWithManagedIdentity returns an ExoConfigFunc that initializes an
+// ExoCreateParams object with the specified values. The managed identity ID is
+// the Azure resource ID of a user-assigned managed identity, which is used as
+// the pod identity of the exocompute cluster. This allows the exocompute
+// cluster to access storage without secrets. Use
+// ExocomputeConfigManagedIdentity to read back the managed identity.
+func ManagedWithManagedIdentity(region, subnetID, managedIdentityID string) ExoConfigFunc {
+	return func(ctx context.Context) (azure.ExoCreateParams, error) {
+		if managedIdentityID == "" {
+			return azure.ExoCreateParams{}, errors.New("managed identity id is not allowed to be empty")
+		}
+
+		return azure.ExoCreateParams{
+			IsManagedByRubrik: true,
+			Region:            azure.RegionFromName(region).ToCloudAccountRegionEnum(),
+			SubnetID:          subnetID,
+			ManagedIdentityID: managedIdentityID,
+		}, nil
+	}
+}
+
 // toExocomputeConfig converts an polaris/graphql/azure exocompute config to an
 // polaris/azure exocompute config.
 func toExocomputeConfig(configID uuid.UUID, config azure.ExoConfig) ExocomputeConfig {
@@ -124,6 +146,32 @@ func (a API) ExocomputeConfig(ctx context.Context, configID uuid.UUID) (Exocompu
 	return ExocomputeConfig{}, fmt.Errorf("exocompute config %w", graphql.ErrNotFound)
 }
 
+// ExocomputeConfigManagedIdentity returns the Azure resource ID of the
+// user-assigned managed identity of the exocompute config with the specified
+// exocompute config ID. An empty string is returned if the exocompute config
+// doesn't use a managed identity. See ManagedWithManagedIdentity.
+func (a API) ExocomputeConfigManagedIdentity(ctx context.Context, configID uuid.UUID) (string, error) {
+	a.log.Print(log.Trace)
+
+	identitiesForAccounts, err := exocompute.ListConfigurations[azure.ExoManagedIdentitiesForAccount](ctx, a.client, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to get exocompute managed identities: %s", err)
+	}
+	for _, identitiesForAccount := range identitiesForAccounts {
+		for _, config := range identitiesForAccount.Configs {
+			id, err := uuid.Parse(config.ID)
+			if err != nil {
+				return "", fmt.Errorf("failed to parse exocompute config id: %s", err)
+			}
+			if id == configID {
+				return config.ManagedIdentityID, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("exocompute config %w", graphql.ErrNotFound)
+}
+
 // ExocomputeConfigs returns all exocompute configs for the account with the
 // specified ID.
 func (a API) ExocomputeConfigs(ctx context.Context, id IdentityFunc) ([]ExocomputeConfig, error) {
@@ -23,13 +23,19 @@ package azure
 import (
 	"context"
 	"errors"
+	"fmt"
 	"maps"
+	"net/http"
 	"slices"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testsetup"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
 )
 
 // TestAzureExocompute verifies that the SDK can perform basic Exocompute
@@ -117,7 +123,7 @@ func TestAzureExocompute(t *testing.T) {
 	if regions := feature.Regions; !slices.Equal(regions, testSubscription.Exocompute.Regions) {
 		t.Fatalf("invalid feature regions: %v", regions)
 	}
-	if status := feature.Status; status != "CONNECTED" {
+	if status := feature.Status; status != core.StatusConnected {
 		t.Fatalf("invalid feature status: %v", status)
 	}
 	if name := feature.ResourceGroup.Name; name != testSubscription.Exocompute.ResourceGroupName {
@@ -195,3 +201,28 @@ func TestAzureExocompute(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestExocomputeConfigManagedIdentity(t *testing.T) {
+	gqlClient, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	azureClient := Wrap(&polaris.Client{GQL: gqlClient})
+
+	configID := uuid.MustParse("e0e1e2e3-0000-4000-8000-000000000001")
+	identityID := "/subscriptions/x/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/exo"
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"data": {"result": [{"configs": [{"configUuid": "%s", "managedIdentityNativeId": "%s"}]}]}}`, configID, identityID)
+	})
+	defer srv.Shutdown(context.Background())
+
+	id, err := azureClient.ExocomputeConfigManagedIdentity(context.Background(), configID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != identityID {
+		t.Errorf("invalid managed identity id: %q", id)
+	}
+
+	_, err = azureClient.ExocomputeConfigManagedIdentity(context.Background(), uuid.New())
+	if !errors.Is(err, graphql.ErrNotFound) {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+}
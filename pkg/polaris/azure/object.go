@@ -0,0 +1,183 @@
+// Copyright 2021 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/azure"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// ObjectType represents the type of an Azure object which can be protected by
+// RSC.
+type ObjectType string
+
+const (
+	ObjectTypeManagedDisk                ObjectType = "MANAGED_DISK"
+	ObjectTypeSQLDatabase                ObjectType = "SQL_DATABASE"
+	ObjectTypeSQLManagedInstanceDatabase ObjectType = "SQL_MANAGED_INSTANCE_DATABASE"
+	ObjectTypeVirtualMachine             ObjectType = "VIRTUAL_MACHINE"
+)
+
+// ProtectedObject represents an Azure object and its protection status in
+// RSC. LastSnapshot is the zero time if the object doesn't have any snapshots.
+type ProtectedObject struct {
+	ID            uuid.UUID // Rubrik object ID.
+	NativeID      string    // Azure object ID.
+	Name          string
+	ObjectType    ObjectType
+	Region        string
+	SLAAssignment core.SLAAssignment
+	SLADomain     core.SLADomain // Effective SLA domain.
+	LastSnapshot  time.Time
+}
+
+// Protected returns true if the object is protected by an SLA domain.
+func (o ProtectedObject) Protected() bool {
+	return o.SLADomain.Protects()
+}
+
+// ProtectedObjects returns all objects of the specified object type for the
+// subscription with the specified id. Both protected and unprotected objects
+// are returned, use ProtectedObject.Protected to tell them apart.
+func (a API) ProtectedObjects(ctx context.Context, id IdentityFunc, objectType ObjectType) ([]ProtectedObject, error) {
+	a.log.Print(log.Trace)
+
+	accountID, err := a.toCloudAccountID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cloud account id: %s", err)
+	}
+
+	var objects []ProtectedObject
+	switch objectType {
+	case ObjectTypeManagedDisk:
+		disks, err := azure.Wrap(a.client).NativeManagedDisks(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get managed disks: %s", err)
+		}
+		for _, disk := range disks {
+			objects = append(objects, ProtectedObject{
+				ID:            disk.ID,
+				NativeID:      disk.NativeID,
+				Name:          disk.Name,
+				ObjectType:    ObjectTypeManagedDisk,
+				Region:        disk.Region.Name(),
+				SLAAssignment: disk.Assignment,
+				SLADomain:     disk.Effective,
+				LastSnapshot:  disk.NewestSnapshot.Time(),
+			})
+		}
+	case ObjectTypeSQLDatabase:
+		databases, err := azure.Wrap(a.client).NativeSQLDatabases(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sql databases: %s", err)
+		}
+		for _, database := range databases {
+			objects = append(objects, ProtectedObject{
+				ID:            database.ID,
+				NativeID:      database.NativeID,
+				Name:          database.Name,
+				ObjectType:    ObjectTypeSQLDatabase,
+				Region:        database.Region.Name(),
+				SLAAssignment: database.Assignment,
+				SLADomain:     database.Effective,
+				LastSnapshot:  database.NewestSnapshot.Time(),
+			})
+		}
+	case ObjectTypeSQLManagedInstanceDatabase:
+		databases, err := azure.Wrap(a.client).NativeSQLManagedInstanceDatabases(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sql managed instance databases: %s", err)
+		}
+		for _, database := range databases {
+			objects = append(objects, ProtectedObject{
+				ID:            database.ID,
+				NativeID:      database.NativeID,
+				Name:          database.Name,
+				ObjectType:    ObjectTypeSQLManagedInstanceDatabase,
+				Region:        database.Region.Name(),
+				SLAAssignment: database.Assignment,
+				SLADomain:     database.Effective,
+				LastSnapshot:  database.NewestSnapshot.Time(),
+			})
+		}
+	case ObjectTypeVirtualMachine:
+		vms, err := azure.Wrap(a.client).NativeVirtualMachines(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get virtual machines: %s", err)
+		}
+		for _, vm := range vms {
+			objects = append(objects, ProtectedObject{
+				ID:            vm.ID,
+				NativeID:      vm.NativeID,
+				Name:          vm.Name,
+				ObjectType:    ObjectTypeVirtualMachine,
+				Region:        vm.Region.Name(),
+				SLAAssignment: vm.Assignment,
+				SLADomain:     vm.Effective,
+				LastSnapshot:  vm.NewestSnapshot.Time(),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("invalid object type: %s", objectType)
+	}
+
+	return objects, nil
+}
+
+// SQLDatabases returns all SQL databases for the subscription with the
+// specified id. Both protected and unprotected databases are returned.
+func (a API) SQLDatabases(ctx context.Context, id IdentityFunc) ([]ProtectedObject, error) {
+	a.log.Print(log.Trace)
+
+	return a.ProtectedObjects(ctx, id, ObjectTypeSQLDatabase)
+}
+
+// SQLManagedInstances returns all databases of the SQL managed instances for
+// the subscription with the specified id. Both protected and unprotected
+// databases are returned.
+func (a API) SQLManagedInstances(ctx context.Context, id IdentityFunc) ([]ProtectedObject, error) {
+	a.log.Print(log.Trace)
+
+	return a.ProtectedObjects(ctx, id, ObjectTypeSQLManagedInstanceDatabase)
+}
+
+// AssignSLADomain assigns the SLA domain with the specified id to the objects
+// with the specified ids. See core.API.AssignSLADomain for details.
+func (a API) AssignSLADomain(ctx context.Context, slaDomainID uuid.UUID, objectIDs []uuid.UUID, applyToExisting bool) error {
+	a.log.Print(log.Trace)
+
+	return core.Wrap(a.client).AssignSLADomain(ctx, slaDomainID, objectIDs, applyToExisting)
+}
+
+// UnassignSLADomain removes the SLA domain directly assigned to the objects
+// with the specified ids, letting them inherit the SLA domain of their subscription.
+// See core.API.UnassignSLADomain for details.
+func (a API) UnassignSLADomain(ctx context.Context, objectIDs []uuid.UUID) error {
+	a.log.Print(log.Trace)
+
+	return core.Wrap(a.client).UnassignSLADomain(ctx, objectIDs)
+}
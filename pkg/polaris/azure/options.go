@@ -22,31 +22,72 @@ package azure
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/azure"
 )
 
 type options struct {
+	cloud               azure.Cloud
 	name                string
 	regions             []azure.Region
 	resourceGroup       *azure.ResourceGroup
 	featureSpecificInfo *azure.FeatureSpecificInfo
+	tags                map[string]string
 }
 
 // OptionFunc gives the value passed to the function creating the OptionFunc
 // to the specified options instance.
 type OptionFunc func(ctx context.Context, opts *options) error
 
-// Name returns an OptionFunc that gives the specified name to the option
+// Cloud returns an OptionFunc that gives the specified Azure cloud to the
+// option instance. Valid values are public, china and usgovernment. When not
+// specified, the public cloud is used.
+func Cloud(cloud string) OptionFunc {
+	return func(ctx context.Context, opts *options) error {
+		c, err := azure.ParseCloud(cloud)
+		if err != nil {
+			return err
+		}
+		opts.cloud = c
+		return nil
+	}
+}
+
+// WithName returns an OptionFunc that gives the specified name to the option
 // instance.
-func Name(name string) OptionFunc {
+func WithName(name string) OptionFunc {
 	return func(ctx context.Context, opts *options) error {
 		opts.name = name
 		return nil
 	}
 }
 
+// Name is an alias for WithName.
+func Name(name string) OptionFunc {
+	return WithName(name)
+}
+
+// WithTags returns an OptionFunc that gives the specified tags to the option
+// instance. The tags are applied to the resource group given by the
+// ResourceGroup option, taking precedence over the tags passed to
+// ResourceGroup. Adding a subscription without a resource group fails if tags
+// are given. Updating a subscription fails if tags are given. Multiple WithTags
+// options are merged.
+func WithTags(tags map[string]string) OptionFunc {
+	return func(ctx context.Context, opts *options) error {
+		if opts.tags == nil {
+			opts.tags = make(map[string]string, len(tags))
+		}
+		for key, value := range tags {
+			opts.tags[key] = value
+		}
+		return nil
+	}
+}
+
 // Region returns an OptionFunc that gives the specified region to the option
 // instance.
 func Region(region string) OptionFunc {
@@ -126,3 +167,52 @@ func ManagedIdentity(name, resourceGroup, principalID, region string) OptionFunc
 		return nil
 	}
 }
+
+// applyTags applies the tags given by the WithTags option to the resource
+// group. Returns an error if there are tags but no resource group.
+func (opts *options) applyTags() error {
+	if len(opts.tags) == 0 {
+		return nil
+	}
+	if opts.resourceGroup == nil {
+		return errors.New("tags require a resource group")
+	}
+
+	tagList := make([]azure.Tag, 0, len(opts.resourceGroup.TagList.Tags)+len(opts.tags))
+	for _, tag := range opts.resourceGroup.TagList.Tags {
+		if _, ok := opts.tags[tag.Key]; !ok {
+			tagList = append(tagList, tag)
+		}
+	}
+	for key, value := range opts.tags {
+		tagList = append(tagList, azure.Tag{Key: key, Value: value})
+	}
+	opts.resourceGroup.TagList.Tags = tagList
+
+	return nil
+}
+
+// validateResourceGroupRegion returns an error if the region of the resource
+// group isn't one of the regions given by the Region and Regions options. If
+// there is no resource group, the resource group has no region or no regions
+// were given, no validation is done.
+func (opts *options) validateResourceGroupRegion() error {
+	if opts.resourceGroup == nil || len(opts.regions) == 0 {
+		return nil
+	}
+	rgRegion := opts.resourceGroup.Region.Region
+	if rgRegion == azure.RegionUnknown {
+		return nil
+	}
+
+	names := make([]string, 0, len(opts.regions))
+	for _, region := range opts.regions {
+		if region == rgRegion {
+			return nil
+		}
+		names = append(names, region.Name())
+	}
+
+	return fmt.Errorf("resource group region %s is not one of the feature regions: %s", rgRegion.Name(),
+		strings.Join(names, ", "))
+}
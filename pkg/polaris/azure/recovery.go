@@ -0,0 +1,92 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package azure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/azure"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// RecoverVMOptions holds the options for recovering a virtual machine snapshot
+// to a new virtual machine. Subscription is the destination subscription and
+// Region the destination region, e.g. eastus, which can differ from the
+// subscription and region of the original virtual machine. ResourceGroup,
+// VMSize, e.g. Standard_B2s, and SubnetID are required since RSC doesn't
+// default them to the values of the original virtual machine.
+type RecoverVMOptions struct {
+	Subscription  IdentityFunc
+	Region        string
+	ResourceGroup string
+	VMName        string
+	VMSize        string
+	SubnetID      string // Azure resource ID of the subnet.
+	PowerOn       bool   // Power on the virtual machine after the recovery.
+}
+
+// RecoverVM starts a job recovering the virtual machine snapshot with the
+// specified id to a new virtual machine. Returns the RSC task chain id of the
+// recovery job, which can be passed to core.API.WaitForTaskChain to track the
+// status of the recovery.
+func (a API) RecoverVM(ctx context.Context, snapshotID uuid.UUID, opts RecoverVMOptions) (uuid.UUID, error) {
+	a.log.Print(log.Trace)
+
+	if opts.Subscription == nil {
+		return uuid.Nil, errors.New("destination subscription is not allowed to be nil")
+	}
+	region := azure.RegionFromName(opts.Region)
+	if region == azure.RegionUnknown {
+		return uuid.Nil, fmt.Errorf("invalid destination region: %q", opts.Region)
+	}
+	if opts.ResourceGroup == "" {
+		return uuid.Nil, errors.New("resource group is not allowed to be empty")
+	}
+	if opts.VMSize == "" {
+		return uuid.Nil, errors.New("vm size is not allowed to be empty")
+	}
+	if opts.SubnetID == "" {
+		return uuid.Nil, errors.New("subnet id is not allowed to be empty")
+	}
+	subscriptionID, err := a.toCloudAccountID(ctx, opts.Subscription)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get cloud account id: %s", err)
+	}
+
+	jobID, err := core.Wrap(a.client).RestoreSnapshot(ctx, snapshotID, azure.VirtualMachineExportTarget{
+		SubscriptionID:    subscriptionID,
+		Region:            region,
+		ResourceGroupName: opts.ResourceGroup,
+		VMName:            opts.VMName,
+		VMSize:            opts.VMSize,
+		SubnetNativeID:    opts.SubnetID,
+		PowerOn:           opts.PowerOn,
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to recover virtual machine: %s", err)
+	}
+
+	return jobID, nil
+}
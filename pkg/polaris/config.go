@@ -399,6 +399,35 @@ func (a *ServiceAccount) cacheSuffixMaterial() string {
 	return a.Name + a.ClientID
 }
 
+// maskedSecret replaces the client secret in the output of String and
+// GoString.
+const maskedSecret = "*****"
+
+// String returns a string representation of the service account with the
+// client secret masked, so that the secret doesn't leak into logs and error
+// messages.
+func (a ServiceAccount) String() string {
+	return fmt.Sprintf("{ClientID: %s, ClientSecret: %s, Name: %s, AccessTokenURI: %s}",
+		a.ClientID, a.maskSecret(), a.Name, a.AccessTokenURI)
+}
+
+// GoString returns a Go syntax representation of the service account with the
+// client secret masked.
+func (a ServiceAccount) GoString() string {
+	return fmt.Sprintf("polaris.ServiceAccount{ClientID:%q, ClientSecret:%q, Name:%q, AccessTokenURI:%q}",
+		a.ClientID, a.maskSecret(), a.Name, a.AccessTokenURI)
+}
+
+// maskSecret returns the masked client secret. An empty client secret is
+// returned as is, so that a missing secret can still be spotted.
+func (a ServiceAccount) maskSecret() string {
+	if a.ClientSecret == "" {
+		return ""
+	}
+
+	return maskedSecret
+}
+
 // DefaultServiceAccount returns a new ServiceAccount read from the RSC service
 // account file at the default service account location.
 //
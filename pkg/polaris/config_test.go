@@ -1,9 +1,20 @@
 package polaris
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/token"
 )
 
 func skipOnEnvs(t *testing.T, keys ...string) {
@@ -435,3 +446,59 @@ func TestDefaultServiceAccountFromEnvCrendentials(t *testing.T) {
 		t.Fatal("no override requires a valid service account file")
 	}
 }
+
+func TestServiceAccountMasksSecret(t *testing.T) {
+	const secret = "my-very-secret-client-secret"
+	account := ServiceAccount{
+		ClientID:       "client|my-client-id",
+		ClientSecret:   secret,
+		Name:           "my-service-account",
+		AccessTokenURI: "https://my-account.my.rubrik.com/api/client_token",
+	}
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		if str := fmt.Sprintf(format, account); strings.Contains(str, secret) {
+			t.Errorf("%s of service account contains the secret: %s", format, str)
+		}
+		if str := fmt.Sprintf(format, &account); strings.Contains(str, secret) {
+			t.Errorf("%s of service account pointer contains the secret: %s", format, str)
+		}
+	}
+	if str := account.String(); !strings.Contains(str, account.ClientID) || !strings.Contains(str, maskedSecret) {
+		t.Errorf("invalid service account string: %s", str)
+	}
+	if str := (ServiceAccount{}).String(); strings.Contains(str, maskedSecret) {
+		t.Errorf("empty secret should not be masked: %s", str)
+	}
+
+	// Run a request through the client with trace logging enabled and verify
+	// that the secret never shows up in the log output.
+	var buf bytes.Buffer
+	stdlog.SetOutput(&buf)
+	defer stdlog.SetOutput(os.Stderr)
+	logger := log.NewStandardLogger()
+	logger.SetLogLevel(log.Trace)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Path == "/api/client_token" {
+			w.Write([]byte(`{"client_id": "` + account.ClientID + `", "access_token": "` + testToken + `"}`))
+			return
+		}
+		w.Write([]byte(`{"data": {}}`))
+	}))
+	defer srv.Close()
+
+	logger.Printf(log.Debug, "service account: %v", account)
+	tokenSource := token.NewServiceAccountSourceWithLogger(srv.Client(), srv.URL+"/api/client_token",
+		account.ClientID, account.ClientSecret, logger)
+	client := graphql.NewClientWithHTTPClient(srv.URL+"/api", tokenSource, srv.Client(), logger)
+	if _, err := client.Request(context.Background(), "query SdkGolangTest { test }", nil); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() == 0 {
+		t.Fatal("expected trace output")
+	}
+	if strings.Contains(buf.String(), secret) {
+		t.Fatalf("trace output contains the secret: %s", buf.String())
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
@@ -65,6 +66,37 @@ type CloudAccount struct {
 	Features              []Feature
 }
 
+var _ core.CloudAccount = CloudAccount{}
+
+// AccountID returns the RSC cloud account ID.
+func (c CloudAccount) AccountID() uuid.UUID {
+	return c.ID
+}
+
+// AccountNativeID returns the GCP project ID.
+func (c CloudAccount) AccountNativeID() string {
+	return c.NativeID
+}
+
+// AccountName returns the name of the cloud account.
+func (c CloudAccount) AccountName() string {
+	return c.Name
+}
+
+// CloudVendor returns core.CloudVendorGCP.
+func (c CloudAccount) CloudVendor() core.CloudVendor {
+	return core.CloudVendorGCP
+}
+
+// AccountFeatures returns the RSC features of the cloud account.
+func (c CloudAccount) AccountFeatures() []core.Feature {
+	features := make([]core.Feature, 0, len(c.Features))
+	for _, feature := range c.Features {
+		features = append(features, feature.Feature)
+	}
+	return features
+}
+
 // Feature returns the specified feature from the CloudAccount's features.
 func (c CloudAccount) Feature(feature core.Feature) (Feature, bool) {
 	for _, f := range c.Features {
@@ -76,10 +108,21 @@ func (c CloudAccount) Feature(feature core.Feature) (Feature, bool) {
 	return Feature{}, false
 }
 
+// FeatureStatuses returns the status of the CloudAccount's features, keyed by
+// feature name.
+func (c CloudAccount) FeatureStatuses() map[string]core.FeatureStatus {
+	statuses := make(map[string]core.FeatureStatus, len(c.Features))
+	for _, f := range c.Features {
+		statuses[f.Name] = f.Status
+	}
+
+	return statuses
+}
+
 // Feature for Google Cloud Platform projects.
 type Feature struct {
 	core.Feature
-	Status core.Status
+	Status core.FeatureStatus
 }
 
 // RSC does not support the AllFeatures for GCP cloud accounts. We work around
@@ -252,6 +295,9 @@ func (a API) Projects(ctx context.Context, feature core.Feature, filter string)
 func (a API) AddProject(ctx context.Context, project ProjectFunc, feature core.Feature, opts ...OptionFunc) (uuid.UUID, error) {
 	a.log.Print(log.Trace)
 
+	if err := core.ValidateFeatures(feature); err != nil {
+		return uuid.Nil, err
+	}
 	if !feature.Equal(core.FeatureCloudNativeProtection) {
 		return uuid.Nil, fmt.Errorf("feature not supported on gcp: %v", feature)
 	}
@@ -286,7 +332,9 @@ func (a API) AddProject(ctx context.Context, project ProjectFunc, feature core.F
 			return uuid.Nil, fmt.Errorf("failed to check permissions: %v", err)
 		}
 
-		jwtConfig = string(config.creds.JSON)
+		if !config.federated {
+			jwtConfig = string(config.creds.JSON)
+		}
 	}
 
 	err = gcp.Wrap(a.client).CloudAccountAddManualAuthProject(ctx, config.id, config.name, config.number,
@@ -304,6 +352,36 @@ func (a API) AddProject(ctx context.Context, project ProjectFunc, feature core.F
 	return account.ID, nil
 }
 
+// EnsureProject makes sure the project is onboarded with the specified
+// feature. If the project doesn't have the feature, the project is added with
+// the feature. Returns the RSC cloud account ID and true if a change was made.
+func (a API) EnsureProject(ctx context.Context, project ProjectFunc, feature core.Feature, opts ...OptionFunc) (uuid.UUID, bool, error) {
+	a.log.Print(log.Trace)
+
+	if project == nil {
+		return uuid.Nil, false, errors.New("project is not allowed to be nil")
+	}
+	config, err := project(ctx)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("failed to lookup project: %v", err)
+	}
+
+	existing, err := a.Project(ctx, ProjectID(config.id), feature)
+	if err != nil && !errors.Is(err, graphql.ErrNotFound) {
+		return uuid.Nil, false, fmt.Errorf("failed to get project: %v", err)
+	}
+	if existingFeature, ok := existing.Feature(feature); err == nil && ok && existingFeature.Status != core.StatusDisabled {
+		return existing.ID, false, nil
+	}
+
+	id, err := a.AddProject(ctx, project, feature, opts...)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+
+	return id, true, nil
+}
+
 // RemoveProject removes the project with the specified id from RSC for the
 // given feature. If deleteSnapshots is true the snapshots are deleted otherwise
 // they are kept. Note that snapshots are only considered to be deleted when
@@ -320,23 +398,10 @@ func (a API) RemoveProject(ctx context.Context, id IdentityFunc, feature core.Fe
 	}
 
 	if account.Features[0].Equal(core.FeatureCloudNativeProtection) && account.Features[0].Status != core.StatusDisabled {
-		// Lookup the RSC Native ID from the GCP project number. The RSC Native
-		// Account ID is needed to delete the RSC Native Project.
-		natives, err := gcp.Wrap(a.client).NativeProjects(ctx, strconv.FormatInt(account.ProjectNumber, 10))
+		// The RSC Native Account ID is needed to delete the RSC Native Project.
+		nativeID, err := a.nativeProjectID(ctx, account)
 		if err != nil {
-			return fmt.Errorf("failed to get native projects: %v", err)
-		}
-
-		// Find the exact match.
-		var nativeID uuid.UUID
-		for _, native := range natives {
-			if native.NativeID == account.NativeID {
-				nativeID = native.ID
-				break
-			}
-		}
-		if nativeID == uuid.Nil {
-			return fmt.Errorf("native project %w", graphql.ErrNotFound)
+			return err
 		}
 
 		jobID, err := gcp.Wrap(a.client).NativeDisableProject(ctx, nativeID, deleteSnapshots)
@@ -368,6 +433,26 @@ func (a API) RemoveProject(ctx context.Context, id IdentityFunc, feature core.Fe
 	return nil
 }
 
+// nativeProjectID returns the RSC native project id for the specified cloud
+// account. The native project is looked up using the GCP project number.
+func (a API) nativeProjectID(ctx context.Context, account CloudAccount) (uuid.UUID, error) {
+	a.log.Print(log.Trace)
+
+	natives, err := gcp.Wrap(a.client).NativeProjects(ctx, strconv.FormatInt(account.ProjectNumber, 10))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get native projects: %v", err)
+	}
+
+	// Find the exact match.
+	for _, native := range natives {
+		if native.NativeID == account.NativeID {
+			return native.ID, nil
+		}
+	}
+
+	return uuid.Nil, fmt.Errorf("native project %w", graphql.ErrNotFound)
+}
+
 // ServiceAccount returns the default service account name. If no default
 // service account has been set an empty string is returned.
 func (a API) ServiceAccount(ctx context.Context) (string, error) {
@@ -400,6 +485,9 @@ func (a API) SetServiceAccount(ctx context.Context, project ProjectFunc, opts ..
 	if config.creds == nil {
 		return errors.New("project is missing credentials")
 	}
+	if config.federated {
+		return errors.New("federated credentials cannot be used as the default service account")
+	}
 
 	var options options
 	for _, option := range opts {
@@ -418,3 +506,22 @@ func (a API) SetServiceAccount(ctx context.Context, project ProjectFunc, opts ..
 
 	return nil
 }
+
+// WaitForFeatureStatus blocks until the feature of the project with the
+// specified id reaches the specified status. If the feature reaches a terminal
+// status other than the specified status, an error is returned.
+func (a API) WaitForFeatureStatus(ctx context.Context, id IdentityFunc, feature core.Feature, status core.FeatureStatus) error {
+	a.log.Print(log.Trace)
+
+	return core.Wrap(a.client).WaitForFeatureStatus(ctx, status, 10*time.Second, func(ctx context.Context) (core.FeatureStatus, error) {
+		project, err := a.Project(ctx, id, feature)
+		if err != nil {
+			return "", err
+		}
+		f, ok := project.Feature(feature)
+		if !ok {
+			return "", fmt.Errorf("feature %s %w", feature, graphql.ErrNotFound)
+		}
+		return f.Status, nil
+	})
+}
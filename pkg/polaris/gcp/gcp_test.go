@@ -232,3 +232,15 @@ func TestGcpProjectAddAndRemoveWithServiceAccountSet(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestAddProjectValidatesFeature(t *testing.T) {
+	gqlClient, _ := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	gcpClient := Wrap(&polaris.Client{GQL: gqlClient})
+
+	// The feature is validated before the project is looked up or any request
+	// is made.
+	_, err := gcpClient.AddProject(context.Background(), nil, core.Feature{Name: "CLOUDNATIVE_PROTECTION"})
+	if err == nil || !strings.Contains(err.Error(), core.FeatureCloudNativeProtection.Name) {
+		t.Errorf("expected invalid feature error listing the valid features, got: %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/gcp"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// ObjectType represents the type of a GCP object which can be protected by
+// RSC.
+type ObjectType string
+
+const (
+	ObjectTypeCloudSQLInstance ObjectType = "CLOUD_SQL_INSTANCE"
+	ObjectTypeDisk             ObjectType = "DISK"
+	ObjectTypeGCEInstance      ObjectType = "GCE_INSTANCE"
+)
+
+// ProtectedObject represents a GCP object and its protection status in RSC.
+// LastSnapshot is the zero time if the object doesn't have any snapshots.
+type ProtectedObject struct {
+	ID            uuid.UUID // Rubrik object ID.
+	NativeID      string    // GCP object ID.
+	Name          string
+	ObjectType    ObjectType
+	Region        string
+	SLAAssignment core.SLAAssignment
+	SLADomain     core.SLADomain // Effective SLA domain.
+	LastSnapshot  time.Time
+}
+
+// Protected returns true if the object is protected by an SLA domain.
+func (o ProtectedObject) Protected() bool {
+	return o.SLADomain.Protects()
+}
+
+// ProtectedObjects returns all objects of the specified object type for the
+// project with the specified id. Both protected and unprotected objects are
+// returned, use ProtectedObject.Protected to tell them apart.
+func (a API) ProtectedObjects(ctx context.Context, id IdentityFunc, objectType ObjectType) ([]ProtectedObject, error) {
+	a.log.Print(log.Trace)
+
+	account, err := a.Project(ctx, id, core.FeatureCloudNativeProtection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %v", err)
+	}
+	nativeID, err := a.nativeProjectID(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []ProtectedObject
+	switch objectType {
+	case ObjectTypeCloudSQLInstance:
+		instances, err := gcp.Wrap(a.client).NativeCloudSQLInstances(ctx, nativeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cloud sql instances: %v", err)
+		}
+		for _, instance := range instances {
+			objects = append(objects, ProtectedObject{
+				ID:            instance.ID,
+				NativeID:      instance.NativeID,
+				Name:          instance.Name,
+				ObjectType:    ObjectTypeCloudSQLInstance,
+				Region:        instance.Region,
+				SLAAssignment: instance.Assignment,
+				SLADomain:     instance.Effective,
+				LastSnapshot:  instance.NewestSnapshot.Time(),
+			})
+		}
+	case ObjectTypeDisk:
+		disks, err := gcp.Wrap(a.client).NativeDisks(ctx, nativeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get disks: %v", err)
+		}
+		for _, disk := range disks {
+			objects = append(objects, ProtectedObject{
+				ID:            disk.ID,
+				NativeID:      disk.NativeID,
+				Name:          disk.Name,
+				ObjectType:    ObjectTypeDisk,
+				Region:        disk.Region,
+				SLAAssignment: disk.Assignment,
+				SLADomain:     disk.Effective,
+				LastSnapshot:  disk.NewestSnapshot.Time(),
+			})
+		}
+	case ObjectTypeGCEInstance:
+		instances, err := gcp.Wrap(a.client).NativeGCEInstances(ctx, nativeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gce instances: %v", err)
+		}
+		for _, instance := range instances {
+			objects = append(objects, ProtectedObject{
+				ID:            instance.ID,
+				NativeID:      instance.NativeID,
+				Name:          instance.Name,
+				ObjectType:    ObjectTypeGCEInstance,
+				Region:        instance.Region,
+				SLAAssignment: instance.Assignment,
+				SLADomain:     instance.Effective,
+				LastSnapshot:  instance.NewestSnapshot.Time(),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("invalid object type: %s", objectType)
+	}
+
+	return objects, nil
+}
+
+// CloudSQLInstances returns all Cloud SQL instances for the project with the
+// specified id. Both protected and unprotected instances are returned.
+func (a API) CloudSQLInstances(ctx context.Context, id IdentityFunc) ([]ProtectedObject, error) {
+	a.log.Print(log.Trace)
+
+	return a.ProtectedObjects(ctx, id, ObjectTypeCloudSQLInstance)
+}
+
+// AssignSLADomain assigns the SLA domain with the specified id to the objects
+// with the specified ids. See core.API.AssignSLADomain for details.
+func (a API) AssignSLADomain(ctx context.Context, slaDomainID uuid.UUID, objectIDs []uuid.UUID, applyToExisting bool) error {
+	a.log.Print(log.Trace)
+
+	return core.Wrap(a.client).AssignSLADomain(ctx, slaDomainID, objectIDs, applyToExisting)
+}
+
+// UnassignSLADomain removes the SLA domain directly assigned to the objects
+// with the specified ids, letting them inherit the SLA domain of their project.
+// See core.API.UnassignSLADomain for details.
+func (a API) UnassignSLADomain(ctx context.Context, objectIDs []uuid.UUID) error {
+	a.log.Print(log.Trace)
+
+	return core.Wrap(a.client).UnassignSLADomain(ctx, objectIDs)
+}
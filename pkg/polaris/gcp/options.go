@@ -22,6 +22,7 @@ package gcp
 
 import (
 	"context"
+	"errors"
 )
 
 type options struct {
@@ -33,15 +34,29 @@ type options struct {
 // to the specified options instance.
 type OptionFunc func(ctx context.Context, opts *options) error
 
-// Name returns an OptionFunc that gives the specified name to the options
+// WithName returns an OptionFunc that gives the specified name to the options
 // instance.
-func Name(name string) OptionFunc {
+func WithName(name string) OptionFunc {
 	return func(ctx context.Context, opts *options) error {
 		opts.name = name
 		return nil
 	}
 }
 
+// Name is an alias for WithName.
+func Name(name string) OptionFunc {
+	return WithName(name)
+}
+
+// WithTags returns an OptionFunc that always fails, adding a GCP project
+// doesn't create any cloud resources which can be tagged. It exists so that
+// the options of all clouds have the same shape.
+func WithTags(tags map[string]string) OptionFunc {
+	return func(ctx context.Context, opts *options) error {
+		return errors.New("tags are not supported for GCP projects")
+	}
+}
+
 // Organization returns an OptionFunc that gives the specified organization
 // name to the options instance.
 func Organization(name string) OptionFunc {
@@ -22,6 +22,7 @@ package gcp
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -42,6 +43,10 @@ type project struct {
 	number  int64
 	orgName string
 	creds   *google.Credentials
+
+	// federated is true when the credentials are federated credentials, which
+	// can only be used by the SDK and must not be passed on to RSC.
+	federated bool
 }
 
 // ProjectFunc returns a project initialized from the values passed to the
@@ -97,6 +102,59 @@ func KeyFileWithProject(keyFile, projectID string) ProjectFunc {
 	}
 }
 
+// WorkloadIdentity returns a ProjectFunc that initializes the project with
+// values from the cloud using workload identity federation credentials, e.g.
+// when running in GKE or GitHub Actions. The credentials are read from the
+// specified credential configuration file, if the file is empty the default
+// credentials are used. The federated credentials are only used by the SDK to
+// look up the project and check permissions, they are not passed on to RSC.
+// Instead, RSC uses the default service account to access the project.
+func WorkloadIdentity(credentialConfigFile, projectID string) ProjectFunc {
+	return func(ctx context.Context) (project, error) {
+		var creds *google.Credentials
+		var err error
+		if credentialConfigFile != "" {
+			creds, err = readCredentials(ctx, credentialConfigFile)
+			if err != nil {
+				return project{}, fmt.Errorf("failed to read credentials: %v", err)
+			}
+		} else {
+			creds, err = google.FindDefaultCredentials(ctx, "https://www.googleapis.com/auth/cloud-platform")
+			if err != nil {
+				return project{}, fmt.Errorf("failed to find the default GCP credentials: %v", err)
+			}
+		}
+		if isServiceAccountKey(creds) {
+			return project{}, errors.New("credentials is a service account key, use KeyFile instead")
+		}
+
+		project, err := gcpProject(ctx, creds, projectID)
+		if err != nil {
+			return project, err
+		}
+		project.federated = true
+
+		return project, nil
+	}
+}
+
+// isServiceAccountKey returns true if the credentials is a service account
+// key.
+func isServiceAccountKey(creds *google.Credentials) bool {
+	if len(creds.JSON) == 0 {
+		return false
+	}
+
+	var key struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(creds.JSON, &key); err != nil {
+		return false
+	}
+
+	return key.Type == "service_account"
+}
+
 // Project returns a ProjectFunc that initializes the project with the
 // specified values.
 func Project(projectID string, projectNumber int64) ProjectFunc {
@@ -0,0 +1,39 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package gcp
+
+import (
+	"testing"
+
+	"golang.org/x/oauth2/google"
+)
+
+func TestIsServiceAccountKey(t *testing.T) {
+	if !isServiceAccountKey(&google.Credentials{JSON: []byte(`{"type": "service_account"}`)}) {
+		t.Error("service account key should be detected")
+	}
+	if isServiceAccountKey(&google.Credentials{JSON: []byte(`{"type": "external_account"}`)}) {
+		t.Error("external account should not be a service account key")
+	}
+	if isServiceAccountKey(&google.Credentials{}) {
+		t.Error("credentials without JSON should not be a service account key")
+	}
+}
@@ -33,6 +33,21 @@ var addRoleAssignmentQuery = `mutation SdkGolangAddRoleAssignment($userIds: [Str
     )
 }`
 
+// createServiceAccount GraphQL query
+var createServiceAccountQuery = `mutation SdkGolangCreateServiceAccount($name: String!, $description: String, $roleIds: [String!]!) {
+    result: createServiceAccount(input: {
+        name:        $name,
+        description: $description,
+        roleIds:     $roleIds
+    }) {
+        clientId
+        clientSecret
+        name
+        description
+        accessTokenUri
+    }
+}`
+
 // createUser GraphQL query
 var createUserQuery = `mutation SdkGolangCreateUser($email: String!, $roleIds: [String!]!) {
   result: createUser(email: $email, roleIds: $roleIds)
@@ -43,6 +58,11 @@ var deleteRoleQuery = `mutation SdkGolangDeleteRole($roleId: String!) {
     result: deleteRole(roleId: $roleId)
 }`
 
+// deleteServiceAccountsFromAccount GraphQL query
+var deleteServiceAccountsFromAccountQuery = `mutation SdkGolangDeleteServiceAccountsFromAccount($ids: [String!]!) {
+    result: deleteServiceAccountsFromAccount(input: {ids: $ids})
+}`
+
 // deleteUserFromAccount GraphQL query
 var deleteUserFromAccountQuery = `mutation SdkGolangDeleteUserFromAccount($ids: [String!]!) {
   result: deleteUsersFromAccount(ids: $ids)
@@ -132,6 +152,28 @@ var roleTemplatesQuery = `query SdkGolangRoleTemplates($after: String, $nameFilt
     }
 }`
 
+// serviceAccounts GraphQL query
+var serviceAccountsQuery = `query SdkGolangServiceAccounts($after: String, $nameFilter: String) {
+    result: serviceAccounts(after: $after, filter: {name: $nameFilter}) {
+        edges {
+            node {
+                clientId
+                name
+                description
+                lastLogin
+                roles {
+                    id
+                    name
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
 // updateRoleAssignments GraphQL query
 var updateRoleAssignmentsQuery = `mutation SdkGolangUpdateRoleAssignments($userIds: [String!]!, $groupIds: [String!], $roleIds: [String!]!) {
     result: updateRoleAssignments(
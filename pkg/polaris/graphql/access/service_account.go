@@ -0,0 +1,178 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package access
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// ServiceAccount represents a service account in RSC. LastLogin is nil if the
+// service account has never been used.
+type ServiceAccount struct {
+	ClientID    string     `json:"clientId"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	LastLogin   *time.Time `json:"lastLogin"`
+	Roles       []Role     `json:"roles"`
+}
+
+// ServiceAccountCredentials holds the credentials of a newly created service
+// account. Note, the client secret is only available when the service account
+// is created.
+type ServiceAccountCredentials struct {
+	ClientID       string `json:"clientId"`
+	ClientSecret   string `json:"clientSecret"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	AccessTokenURI string `json:"accessTokenUri"`
+}
+
+// ServiceAccounts returns the service accounts matching the specified name
+// filter.
+func (a API) ServiceAccounts(ctx context.Context, nameFilter string) ([]ServiceAccount, error) {
+	a.log.Print(log.Trace)
+
+	query := serviceAccountsQuery
+	var accounts []ServiceAccount
+	var cursor string
+	for {
+		buf, err := a.GQL.Request(ctx, query, struct {
+			After      string `json:"after,omitempty"`
+			NameFilter string `json:"nameFilter,omitempty"`
+		}{After: cursor, NameFilter: nameFilter})
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		graphql.LogResponse(a.log, query, buf)
+
+		var payload struct {
+			Data struct {
+				Result struct {
+					Edges []struct {
+						Node ServiceAccount `json:"node"`
+					} `json:"edges"`
+					PageInfo struct {
+						EndCursor   string `json:"endCursor"`
+						HasNextPage bool   `json:"hasNextPage"`
+					} `json:"pageInfo"`
+				} `json:"result"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(buf, &payload); err != nil {
+			return nil, graphql.UnmarshalError(query, err)
+		}
+		for _, account := range payload.Data.Result.Edges {
+			accounts = append(accounts, account.Node)
+		}
+
+		if !payload.Data.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Data.Result.PageInfo.EndCursor
+	}
+
+	return accounts, nil
+}
+
+// ServiceAccountByClientID returns the service account with the specified
+// client ID. If no service account with the client ID is found, an error
+// wrapping graphql.ErrNotFound is returned.
+func (a API) ServiceAccountByClientID(ctx context.Context, clientID string) (ServiceAccount, error) {
+	a.log.Print(log.Trace)
+
+	// RSC can only filter service accounts by name, which isn't unique and can
+	// be changed, so all service accounts are searched for the client ID.
+	accounts, err := a.ServiceAccounts(ctx, "")
+	if err != nil {
+		return ServiceAccount{}, err
+	}
+	for _, account := range accounts {
+		if account.ClientID == clientID {
+			return account, nil
+		}
+	}
+
+	return ServiceAccount{}, fmt.Errorf("service account %q %w", clientID, graphql.ErrNotFound)
+}
+
+// CreateServiceAccount creates a new service account with the specified name,
+// description and roles.
+func (a API) CreateServiceAccount(ctx context.Context, name, description string, roleIDs []uuid.UUID) (ServiceAccountCredentials, error) {
+	a.log.Print(log.Trace)
+
+	query := createServiceAccountQuery
+	buf, err := a.GQL.Request(ctx, query, struct {
+		Name        string      `json:"name"`
+		Description string      `json:"description,omitempty"`
+		RoleIDs     []uuid.UUID `json:"roleIds"`
+	}{Name: name, Description: description, RoleIDs: roleIDs})
+	if err != nil {
+		return ServiceAccountCredentials{}, graphql.RequestError(query, err)
+	}
+	graphql.LogResponse(a.log, query, buf)
+
+	var payload struct {
+		Data struct {
+			Result ServiceAccountCredentials `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(buf, &payload); err != nil {
+		return ServiceAccountCredentials{}, graphql.UnmarshalError(query, err)
+	}
+
+	return payload.Data.Result, nil
+}
+
+// DeleteServiceAccountsFromAccount deletes the service accounts with the
+// specified client IDs.
+func (a API) DeleteServiceAccountsFromAccount(ctx context.Context, clientIDs []string) error {
+	a.log.Print(log.Trace)
+
+	query := deleteServiceAccountsFromAccountQuery
+	buf, err := a.GQL.Request(ctx, query, struct {
+		IDs []string `json:"ids"`
+	}{IDs: clientIDs})
+	if err != nil {
+		return graphql.RequestError(query, err)
+	}
+	graphql.LogResponse(a.log, query, buf)
+
+	var payload struct {
+		Data struct {
+			Result bool `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(buf, &payload); err != nil {
+		return graphql.UnmarshalError(query, err)
+	}
+	if !payload.Data.Result {
+		return graphql.ResponseError(query, fmt.Errorf("failed to delete service accounts: %v", clientIDs))
+	}
+
+	return nil
+}
@@ -60,6 +60,25 @@ func ParseCloud(cloud string) (Cloud, error) {
 	}
 }
 
+// CloudFromPartition returns the Cloud matching the given AWS partition, e.g.
+// aws-us-gov.
+func CloudFromPartition(partition string) (Cloud, error) {
+	switch partition {
+	case "aws":
+		return CloudStandard, nil
+	case "aws-cn":
+		return CloudChina, nil
+	case "aws-us-gov":
+		return CloudGov, nil
+	case "aws-iso":
+		return CloudC2S, nil
+	case "aws-iso-b":
+		return CloudSC2S, nil
+	default:
+		return CloudStandard, fmt.Errorf("invalid partition: %s", partition)
+	}
+}
+
 // ProtectionFeature represents the protection features of an AWS cloud
 // account.
 type ProtectionFeature string
@@ -151,6 +170,41 @@ var validRegions = map[Region]struct{}{
 	RegionUsWest2:      {},
 }
 
+// Cloud returns the cloud the region belongs to. Note, the regions of the C2S
+// and SC2S clouds are not known by the SDK.
+func (region Region) Cloud() Cloud {
+	switch {
+	case strings.HasPrefix(string(region), "CN_"):
+		return CloudChina
+	case strings.HasPrefix(string(region), "US_GOV_"):
+		return CloudGov
+	default:
+		return CloudStandard
+	}
+}
+
+// ValidateRegions returns an error if any of the regions doesn't belong to the
+// specified cloud. An empty cloud is treated as the standard cloud. Regions
+// aren't validated for the C2S and SC2S clouds, since their regions are not
+// known by the SDK.
+func ValidateRegions(cloud Cloud, regions []Region) error {
+	if cloud == "" {
+		cloud = CloudStandard
+	}
+	if cloud == CloudC2S || cloud == CloudSC2S {
+		return nil
+	}
+
+	for _, region := range regions {
+		if regionCloud := region.Cloud(); regionCloud != cloud {
+			return fmt.Errorf("region %s belongs to the %s cloud, not the %s cloud", FormatRegion(region),
+				regionCloud, cloud)
+		}
+	}
+
+	return nil
+}
+
 // Deprecated: use ParseRegionNoValidation.
 func ParseRegion(region string) (Region, error) {
 	// Polaris region name.
@@ -47,3 +47,69 @@ func TestParseRegion(t *testing.T) {
 		t.Errorf("invalid region: %v", regions)
 	}
 }
+
+func TestValidateRegions(t *testing.T) {
+	if err := ValidateRegions(CloudStandard, []Region{RegionUsEast1, RegionEuNorth1}); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateRegions("", []Region{RegionUsEast1}); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateRegions(CloudGov, []Region{RegionUsGovEast1, RegionUsGovWest1}); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateRegions(CloudChina, []Region{RegionCnNorth1, RegionCnNorthWest1}); err != nil {
+		t.Error(err)
+	}
+
+	if err := ValidateRegions(CloudStandard, []Region{RegionUsEast1, RegionUsGovWest1}); err == nil {
+		t.Error("gov region should be rejected for the standard cloud")
+	}
+	if err := ValidateRegions(CloudGov, []Region{RegionUsEast1}); err == nil {
+		t.Error("standard region should be rejected for the gov cloud")
+	}
+	if err := ValidateRegions(CloudChina, []Region{RegionUsGovEast1}); err == nil {
+		t.Error("gov region should be rejected for the china cloud")
+	}
+}
+
+func TestCloudFromPartition(t *testing.T) {
+	if cloud, err := CloudFromPartition("aws-us-gov"); err != nil || cloud != CloudGov {
+		t.Errorf("invalid cloud: %v, %v", cloud, err)
+	}
+	if cloud, err := CloudFromPartition("aws-cn"); err != nil || cloud != CloudChina {
+		t.Errorf("invalid cloud: %v, %v", cloud, err)
+	}
+	if _, err := CloudFromPartition("azure"); err == nil {
+		t.Error("invalid partition should fail")
+	}
+}
+
+func TestExternalIDFromTrustPolicy(t *testing.T) {
+	doc := `{
+		"Version": "2012-10-17",
+		"Statement": [{
+			"Effect": "Allow",
+			"Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+			"Action": "sts:AssumeRole",
+			"Condition": {"StringEquals": {"sts:ExternalId": "3a7f1d7e-external"}}
+		}]
+	}`
+	if externalID, err := ExternalIDFromTrustPolicy(doc); err != nil || externalID != "3a7f1d7e-external" {
+		t.Errorf("invalid external id: %q, %v", externalID, err)
+	}
+
+	doc = `{"Statement": {"Condition": {"StringEquals": {"sts:ExternalId": ["id-1", "id-2"]}}}}`
+	if externalID, err := ExternalIDFromTrustPolicy(doc); err != nil || externalID != "id-1" {
+		t.Errorf("invalid external id: %q, %v", externalID, err)
+	}
+
+	doc = `{"Statement": [{"Action": "sts:AssumeRole"}]}`
+	if externalID, err := ExternalIDFromTrustPolicy(doc); err != nil || externalID != "" {
+		t.Errorf("invalid external id: %q, %v", externalID, err)
+	}
+
+	if _, err := ExternalIDFromTrustPolicy("not json"); err == nil {
+		t.Error("invalid trust policy should fail")
+	}
+}
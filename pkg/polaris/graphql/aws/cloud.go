@@ -51,7 +51,7 @@ type Feature struct {
 	Regions          []Region               `json:"awsRegions"`
 	RoleArn          string                 `json:"roleArn"`
 	StackArn         string                 `json:"stackArn"`
-	Status           core.Status            `json:"status"`
+	Status           core.FeatureStatus     `json:"status"`
 }
 
 // FeatureVersion maps an RSC Cloud Account feature to a version number.
@@ -95,6 +95,52 @@ type TrustPolicyArtifact struct {
 	ErrorMessage        string `json:"errorMessage"`
 }
 
+// ExternalIDFromTrustPolicy returns the external ID from the sts:ExternalId
+// condition of the trust policy document. If the trust policy document has no
+// external ID condition, the empty string is returned.
+func ExternalIDFromTrustPolicy(trustPolicyDoc string) (string, error) {
+	var doc struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(trustPolicyDoc), &doc); err != nil {
+		return "", fmt.Errorf("failed to unmarshal trust policy: %s", err)
+	}
+
+	// The statement element can be either a single statement or a list of
+	// statements. Condition values can be either a single value or a list of
+	// values.
+	type statement struct {
+		Condition map[string]map[string]json.RawMessage `json:"Condition"`
+	}
+	var statements []statement
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var single statement
+		if err := json.Unmarshal(doc.Statement, &single); err != nil {
+			return "", fmt.Errorf("failed to unmarshal trust policy statement: %s", err)
+		}
+		statements = []statement{single}
+	}
+	for _, statement := range statements {
+		for _, condition := range statement.Condition {
+			value, ok := condition["sts:ExternalId"]
+			if !ok {
+				continue
+			}
+			var externalID string
+			if err := json.Unmarshal(value, &externalID); err == nil {
+				return externalID, nil
+			}
+			var externalIDs []string
+			if err := json.Unmarshal(value, &externalIDs); err == nil && len(externalIDs) > 0 {
+				return externalIDs[0], nil
+			}
+			return "", fmt.Errorf("invalid sts:ExternalId condition value: %s", value)
+		}
+	}
+
+	return "", nil
+}
+
 // TrustPolicy returns the trust policy for the specified account and external
 // id.
 func (a API) TrustPolicy(ctx context.Context, cloud Cloud, features []core.Feature, trustPolicyAccounts []TrustPolicyAccount) ([]TrustPolicy, error) {
@@ -28,6 +28,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
 )
@@ -155,3 +156,287 @@ func (a API) StartNativeAccountDisableJob(ctx context.Context, id uuid.UUID, fea
 
 	return payload.Data.Query.JobID, nil
 }
+
+// NativeEC2Instance represents an AWS EC2 instance in RSC. NewestSnapshot is
+// nil if the instance has no snapshots.
+type NativeEC2Instance struct {
+	ID             uuid.UUID          `json:"id"`
+	NativeID       string             `json:"instanceNativeId"`
+	Name           string             `json:"instanceName"`
+	InstanceType   string             `json:"instanceType"`
+	Region         Region             `json:"region"`
+	AccountID      uuid.UUID          `json:"awsAccountRubrikId"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeEC2Instances returns the EC2 instances, which aren't relics, for the
+// native account with the specified RSC native account id.
+func (a API) NativeEC2Instances(ctx context.Context, accountID uuid.UUID) ([]NativeEC2Instance, error) {
+	a.log.Print(log.Trace)
+
+	query := awsNativeEc2InstancesQuery
+	var instances []NativeEC2Instance
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeEC2Instance `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After     string    `json:"after,omitempty"`
+			AccountID uuid.UUID `json:"accountId"`
+		}{After: cursor, AccountID: accountID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, instance := range payload.Result.Edges {
+			instances = append(instances, instance.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return instances, nil
+}
+
+// NativeEBSVolume represents an AWS EBS volume in RSC. NewestSnapshot is nil
+// if the volume has no snapshots.
+type NativeEBSVolume struct {
+	ID             uuid.UUID          `json:"id"`
+	NativeID       string             `json:"volumeNativeId"`
+	Name           string             `json:"volumeName"`
+	VolumeType     string             `json:"volumeType"`
+	SizeInGiB      int                `json:"sizeInGiBs"`
+	Region         Region             `json:"region"`
+	AccountID      uuid.UUID          `json:"awsAccountRubrikId"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeEBSVolumes returns the EBS volumes, which aren't relics, for the native
+// account with the specified RSC native account id.
+func (a API) NativeEBSVolumes(ctx context.Context, accountID uuid.UUID) ([]NativeEBSVolume, error) {
+	a.log.Print(log.Trace)
+
+	query := awsNativeEbsVolumesQuery
+	var volumes []NativeEBSVolume
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeEBSVolume `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After     string    `json:"after,omitempty"`
+			AccountID uuid.UUID `json:"accountId"`
+		}{After: cursor, AccountID: accountID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, volume := range payload.Result.Edges {
+			volumes = append(volumes, volume.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return volumes, nil
+}
+
+// NativeDynamoDBTable represents an AWS DynamoDB table in RSC. NewestSnapshot
+// is nil if the table has no snapshots.
+type NativeDynamoDBTable struct {
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"nativeName"`
+	ARN            string    `json:"tableArn"`
+	Region         Region    `json:"region"`
+	AccountDetails struct {
+		ID uuid.UUID `json:"id"`
+	} `json:"awsNativeAccountDetails"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeDynamoDBTables returns the DynamoDB tables, which aren't relics, for
+// the native account with the specified RSC native account id.
+func (a API) NativeDynamoDBTables(ctx context.Context, accountID uuid.UUID) ([]NativeDynamoDBTable, error) {
+	a.log.Print(log.Trace)
+
+	query := awsNativeDynamoDbTablesQuery
+	var tables []NativeDynamoDBTable
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeDynamoDBTable `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After     string    `json:"after,omitempty"`
+			AccountID uuid.UUID `json:"accountId"`
+		}{After: cursor, AccountID: accountID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, table := range payload.Result.Edges {
+			tables = append(tables, table.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return tables, nil
+}
+
+// NativeS3Bucket represents an AWS S3 bucket in RSC. NewestSnapshot is nil if
+// the bucket has no snapshots.
+type NativeS3Bucket struct {
+	ID             uuid.UUID `json:"id"`
+	NativeID       string    `json:"bucketNativeId"`
+	Name           string    `json:"name"`
+	Region         Region    `json:"region"`
+	AccountDetails struct {
+		ID uuid.UUID `json:"id"`
+	} `json:"awsNativeAccountDetails"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeS3Buckets returns the S3 buckets, which aren't relics, for the native
+// account with the specified RSC native account id.
+func (a API) NativeS3Buckets(ctx context.Context, accountID uuid.UUID) ([]NativeS3Bucket, error) {
+	a.log.Print(log.Trace)
+
+	query := awsNativeS3BucketsQuery
+	var buckets []NativeS3Bucket
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeS3Bucket `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After     string    `json:"after,omitempty"`
+			AccountID uuid.UUID `json:"accountId"`
+		}{After: cursor, AccountID: accountID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, bucket := range payload.Result.Edges {
+			buckets = append(buckets, bucket.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return buckets, nil
+}
+
+// NativeRDSInstance represents an AWS RDS instance in RSC. NewestSnapshot is
+// nil if the instance has no snapshots.
+type NativeRDSInstance struct {
+	ID             uuid.UUID          `json:"id"`
+	NativeID       string             `json:"dbiResourceId"`
+	Name           string             `json:"dbInstanceName"`
+	InstanceClass  string             `json:"dbInstanceClass"`
+	Engine         string             `json:"dbEngine"`
+	Region         Region             `json:"region"`
+	AccountID      uuid.UUID          `json:"awsAccountRubrikId"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeRDSInstances returns the RDS instances, which aren't relics, for the
+// native account with the specified RSC native account id.
+func (a API) NativeRDSInstances(ctx context.Context, accountID uuid.UUID) ([]NativeRDSInstance, error) {
+	a.log.Print(log.Trace)
+
+	query := awsNativeRdsInstancesQuery
+	var instances []NativeRDSInstance
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeRDSInstance `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After     string    `json:"after,omitempty"`
+			AccountID uuid.UUID `json:"accountId"`
+		}{After: cursor, AccountID: accountID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, instance := range payload.Result.Edges {
+			instances = append(instances, instance.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return instances, nil
+}
@@ -260,6 +260,215 @@ var awsNativeAccountsQuery = `query SdkGolangAwsNativeAccounts($after: String, $
 	}
 }`
 
+// awsNativeDynamoDbTables GraphQL query
+var awsNativeDynamoDbTablesQuery = `query SdkGolangAwsNativeDynamoDbTables($after: String, $accountId: String!) {
+    result: awsNativeDynamoDbTables(after: $after, dynamoDbTableFilters: {
+        awsNativeAccountIdFilter: {
+            ids: [$accountId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                nativeName
+                tableArn
+                region
+                awsNativeAccountDetails {
+                    id
+                }
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// awsNativeEbsVolumes GraphQL query
+var awsNativeEbsVolumesQuery = `query SdkGolangAwsNativeEbsVolumes($after: String, $accountId: String!) {
+    result: awsNativeEbsVolumes(after: $after, ebsVolumeFilters: {
+        accountFilter: {
+            ids: [$accountId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                volumeNativeId
+                volumeName
+                volumeType
+                sizeInGiBs
+                region
+                awsAccountRubrikId
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// awsNativeEc2Instances GraphQL query
+var awsNativeEc2InstancesQuery = `query SdkGolangAwsNativeEc2Instances($after: String, $accountId: String!) {
+    result: awsNativeEc2Instances(after: $after, ec2InstanceFilters: {
+        accountFilter: {
+            ids: [$accountId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                instanceNativeId
+                instanceName
+                instanceType
+                region
+                awsAccountRubrikId
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// awsNativeRdsInstances GraphQL query
+var awsNativeRdsInstancesQuery = `query SdkGolangAwsNativeRdsInstances($after: String, $accountId: String!) {
+    result: awsNativeRdsInstances(after: $after, rdsInstanceFilters: {
+        accountFilter: {
+            ids: [$accountId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                dbiResourceId
+                dbInstanceName
+                dbInstanceClass
+                dbEngine
+                region
+                awsAccountRubrikId
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// awsNativeS3Buckets GraphQL query
+var awsNativeS3BucketsQuery = `query SdkGolangAwsNativeS3Buckets($after: String, $accountId: String!) {
+    result: awsNativeS3Buckets(after: $after, s3BucketFilters: {
+        awsNativeAccountIdFilter: {
+            ids: [$accountId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                name
+                bucketNativeId
+                region
+                awsNativeAccountDetails {
+                    id
+                }
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
 // awsTrustPolicy GraphQL query
 var awsTrustPolicyQuery = `query SdkGolangAwsTrustPolicy($cloudType: AwsCloudType!, $features: [CloudAccountFeature!]!, $awsNativeAccounts: [AwsNativeAccountInput!]!) {
     result: awsTrustPolicy(input: {cloudType: $cloudType, features: $features, awsNativeAccounts: $awsNativeAccounts}) {
@@ -481,6 +690,35 @@ var startAwsNativeAccountDisableJobQuery = `mutation SdkGolangStartAwsNativeAcco
     }
 }`
 
+// startAwsNativeEc2InstanceSnapshotExportJob GraphQL query
+var startAwsNativeEc2InstanceSnapshotExportJobQuery = `mutation SdkGolangStartAwsNativeEc2InstanceSnapshotExportJob($snapshotId: UUID!, $destinationAwsAccountRubrikId: UUID!, $destinationRegionId: AwsNativeRegion!, $instanceName: String!, $instanceType: AwsNativeEc2InstanceType!, $subnetId: String!, $securityGroupIds: [String!]!, $shouldCopyTags: Boolean!, $shouldPowerOn: Boolean!) {
+    result: startAwsNativeEc2InstanceSnapshotExportJob(input: {
+        snapshotId:                    $snapshotId,
+        destinationAwsAccountRubrikId: $destinationAwsAccountRubrikId,
+        destinationRegionId:           $destinationRegionId,
+        instanceName:                  $instanceName,
+        instanceType:                  $instanceType,
+        subnetId:                      $subnetId,
+        securityGroupIds:              $securityGroupIds,
+        shouldCopyTags:                $shouldCopyTags,
+        shouldPowerOn:                 $shouldPowerOn
+    }) {
+        error
+        jobId
+    }
+}`
+
+// startRestoreAwsNativeEc2InstanceSnapshotJob GraphQL query
+var startRestoreAwsNativeEc2InstanceSnapshotJobQuery = `mutation SdkGolangStartRestoreAwsNativeEc2InstanceSnapshotJob($snapshotId: UUID!, $shouldPowerOn: Boolean!) {
+    result: startRestoreAwsNativeEc2InstanceSnapshotJob(input: {
+        snapshotId:    $snapshotId,
+        shouldPowerOn: $shouldPowerOn
+    }) {
+        error
+        jobId
+    }
+}`
+
 // unmapCloudAccountExocomputeAccount GraphQL query
 var unmapCloudAccountExocomputeAccountQuery = `mutation SdkGolangUnmapCloudAccountExocomputeAccount($cloudAccountIds: [UUID!]!) {
     result: unmapCloudAccountExocomputeAccount(input: {
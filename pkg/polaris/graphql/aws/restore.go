@@ -0,0 +1,83 @@
+// Copyright 2021 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package aws
+
+import (
+	"github.com/google/uuid"
+)
+
+// EC2InstanceRestoreTarget restores an EC2 instance snapshot in place,
+// replacing the original instance. Use with core.API.RestoreSnapshot.
+type EC2InstanceRestoreTarget struct {
+	PowerOn bool // Power on the instance after the restore.
+}
+
+// RestoreQuery returns the restore query and its parameters.
+func (t EC2InstanceRestoreTarget) RestoreQuery(snapshotID uuid.UUID) (string, any) {
+	return startRestoreAwsNativeEc2InstanceSnapshotJobQuery, struct {
+		SnapshotID uuid.UUID `json:"snapshotId"`
+		PowerOn    bool      `json:"shouldPowerOn"`
+	}{SnapshotID: snapshotID, PowerOn: t.PowerOn}
+}
+
+// EC2InstanceExportTarget exports an EC2 instance snapshot to a new instance.
+// The new instance can be created in a different region and in a different
+// account than the original instance. Use with core.API.RestoreSnapshot.
+type EC2InstanceExportTarget struct {
+	AccountID        uuid.UUID // RSC cloud account ID of the destination account.
+	Region           Region    // Destination region.
+	InstanceName     string
+	InstanceType     string
+	SubnetID         string
+	SecurityGroupIDs []string
+	CopyTags         bool // Copy the tags of the original instance.
+	PowerOn          bool // Power on the instance after the export.
+}
+
+// RestoreQuery returns the export query and its parameters.
+func (t EC2InstanceExportTarget) RestoreQuery(snapshotID uuid.UUID) (string, any) {
+	securityGroupIDs := t.SecurityGroupIDs
+	if securityGroupIDs == nil {
+		securityGroupIDs = []string{}
+	}
+
+	return startAwsNativeEc2InstanceSnapshotExportJobQuery, struct {
+		SnapshotID       uuid.UUID `json:"snapshotId"`
+		AccountID        uuid.UUID `json:"destinationAwsAccountRubrikId"`
+		Region           Region    `json:"destinationRegionId"`
+		InstanceName     string    `json:"instanceName"`
+		InstanceType     string    `json:"instanceType"`
+		SubnetID         string    `json:"subnetId"`
+		SecurityGroupIDs []string  `json:"securityGroupIds"`
+		CopyTags         bool      `json:"shouldCopyTags"`
+		PowerOn          bool      `json:"shouldPowerOn"`
+	}{
+		SnapshotID:       snapshotID,
+		AccountID:        t.AccountID,
+		Region:           t.Region,
+		InstanceName:     t.InstanceName,
+		InstanceType:     t.InstanceType,
+		SubnetID:         t.SubnetID,
+		SecurityGroupIDs: securityGroupIDs,
+		CopyTags:         t.CopyTags,
+		PowerOn:          t.PowerOn,
+	}
+}
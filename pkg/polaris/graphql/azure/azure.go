@@ -28,6 +28,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -39,10 +41,29 @@ import (
 type Cloud string
 
 const (
-	ChinaCloud  Cloud = "AZURECHINACLOUD"
-	PublicCloud Cloud = "AZUREPUBLICCLOUD"
+	ChinaCloud        Cloud = "AZURECHINACLOUD"
+	PublicCloud       Cloud = "AZUREPUBLICCLOUD"
+	USGovernmentCloud Cloud = "AZUREUSGOVERNMENTCLOUD"
 )
 
+// ParseCloud returns the Cloud matching the given cloud string. The string is
+// matched case-insensitively, with or without the AZURE prefix and CLOUD
+// suffix, e.g., "public" matches PublicCloud.
+func ParseCloud(cloud string) (Cloud, error) {
+	c := strings.ToUpper(cloud)
+	c = strings.TrimPrefix(strings.TrimSuffix(c, "CLOUD"), "AZURE")
+	switch c {
+	case "CHINA":
+		return ChinaCloud, nil
+	case "PUBLIC":
+		return PublicCloud, nil
+	case "USGOVERNMENT":
+		return USGovernmentCloud, nil
+	default:
+		return PublicCloud, fmt.Errorf("invalid cloud: %s", cloud)
+	}
+}
+
 // API wraps around GraphQL clients to give them the RSC Azure API.
 type API struct {
 	Version string // Deprecated: use GQL.DeploymentVersion
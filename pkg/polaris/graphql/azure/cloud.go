@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
@@ -57,7 +58,7 @@ type Feature struct {
 	Feature                     string                             `json:"feature"`
 	ResourceGroup               FeatureResourceGroup               `json:"resourceGroup"`
 	Regions                     []CloudAccountRegionEnum           `json:"regions"`
-	Status                      core.Status                        `json:"status"`
+	Status                      core.FeatureStatus                 `json:"status"`
 	UserAssignedManagedIdentity FeatureUserAssignedManagedIdentity `json:"userAssignedManagedIdentity"`
 }
 
@@ -105,6 +106,25 @@ type UserAssignedManagedIdentity struct {
 	Region            CloudAccountRegionEnum `json:"region"`
 }
 
+// Validate returns an error if the user-assigned managed identity is missing
+// one of its fields or if the principal ID isn't a UUID.
+func (identity UserAssignedManagedIdentity) Validate() error {
+	if identity.Name == "" {
+		return errors.New("managed identity name is not allowed to be empty")
+	}
+	if identity.ResourceGroupName == "" {
+		return errors.New("managed identity resource group is not allowed to be empty")
+	}
+	if identity.Region.Region == RegionUnknown {
+		return errors.New("managed identity region is not allowed to be empty")
+	}
+	if _, err := uuid.Parse(identity.PrincipalID); err != nil {
+		return fmt.Errorf("managed identity principal ID %q is not a valid UUID", identity.PrincipalID)
+	}
+
+	return nil
+}
+
 // CloudAccountFeature holds the information for a particular feature when it's
 // onboarded.
 type CloudAccountFeature struct {
@@ -0,0 +1,59 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package azure
+
+import "testing"
+
+func TestUserAssignedManagedIdentityValidate(t *testing.T) {
+	identity := UserAssignedManagedIdentity{
+		Name:              "my-identity",
+		ResourceGroupName: "my-resource-group",
+		PrincipalID:       "6ab5ae04-5c0a-4800-8793-1a94a2ab8f40",
+		Region:            RegionEastUS.ToCloudAccountRegionEnum(),
+	}
+	if err := identity.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := identity
+	invalid.PrincipalID = "my-principal"
+	if err := invalid.Validate(); err == nil {
+		t.Error("invalid principal ID should fail")
+	}
+
+	invalid = identity
+	invalid.Name = ""
+	if err := invalid.Validate(); err == nil {
+		t.Error("empty name should fail")
+	}
+
+	invalid = identity
+	invalid.ResourceGroupName = ""
+	if err := invalid.Validate(); err == nil {
+		t.Error("empty resource group should fail")
+	}
+
+	invalid = identity
+	invalid.Region = RegionUnknown.ToCloudAccountRegionEnum()
+	if err := invalid.Validate(); err == nil {
+		t.Error("unknown region should fail")
+	}
+}
@@ -41,6 +41,23 @@ func (r ExoConfigsForAccount) ListQuery(filter string) (string, any) {
 	}{Filter: filter}
 }
 
+// ExoManagedIdentitiesForAccount holds the user-assigned managed identities of
+// all exocompute configurations for a specific account. The managed identities
+// are read using a separate query, so that listing exocompute configurations
+// doesn't depend on the managed identity field.
+type ExoManagedIdentitiesForAccount struct {
+	Configs []struct {
+		ID                string `json:"configUuid"`
+		ManagedIdentityID string `json:"managedIdentityNativeId"`
+	} `json:"configs"`
+}
+
+func (r ExoManagedIdentitiesForAccount) ListQuery(filter string) (string, any) {
+	return allAzureExocomputeConfigManagedIdentitiesQuery, struct {
+		Filter string `json:"azureExocomputeSearchQuery"`
+	}{Filter: filter}
+}
+
 // ExoConfig represents a single exocompute configuration.
 type ExoConfig struct {
 	ID                    string                 `json:"configUuid"`
@@ -68,6 +85,7 @@ type ExoCreateParams struct {
 	IsManagedByRubrik     bool                   `json:"isRscManaged"` // When true, Rubrik will manage the security groups.
 	PodOverlayNetworkCIDR string                 `json:"podOverlayNetworkCidr,omitempty"`
 	PodSubnetID           string                 `json:"podSubnetNativeId,omitempty"`
+	ManagedIdentityID     string                 `json:"managedIdentityNativeId,omitempty"` // User-assigned managed identity used for pod identity.
 }
 
 // ExoCreateResult represents the result of creating an Azure exocompute
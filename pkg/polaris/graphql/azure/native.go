@@ -27,6 +27,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
 )
@@ -91,6 +92,117 @@ func (a API) NativeSubscriptions(ctx context.Context, filter string) ([]NativeSu
 	return subscriptions, nil
 }
 
+// NativeVirtualMachine represents an Azure virtual machine in RSC.
+// NewestSnapshot is nil if the virtual machine has no snapshots.
+type NativeVirtualMachine struct {
+	ID             uuid.UUID          `json:"id"`
+	NativeID       string             `json:"virtuaMachineId"`
+	Name           string             `json:"name"`
+	SizeType       string             `json:"sizeType"`
+	Region         NativeRegionEnum   `json:"region"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeVirtualMachines returns the virtual machines, which aren't relics, for
+// the native subscription with the specified RSC native subscription id.
+func (a API) NativeVirtualMachines(ctx context.Context, subscriptionID uuid.UUID) ([]NativeVirtualMachine, error) {
+	a.log.Print(log.Trace)
+
+	query := azureNativeVirtualMachinesQuery
+	var vms []NativeVirtualMachine
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeVirtualMachine `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After          string    `json:"after,omitempty"`
+			SubscriptionID uuid.UUID `json:"subscriptionId"`
+		}{After: cursor, SubscriptionID: subscriptionID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, vm := range payload.Result.Edges {
+			vms = append(vms, vm.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return vms, nil
+}
+
+// NativeManagedDisk represents an Azure managed disk in RSC. NewestSnapshot is
+// nil if the managed disk has no snapshots.
+type NativeManagedDisk struct {
+	ID             uuid.UUID          `json:"id"`
+	NativeID       string             `json:"diskNativeId"`
+	Name           string             `json:"diskName"`
+	StorageTier    string             `json:"diskStorageTier"`
+	SizeInGiB      int                `json:"diskSizeGib"`
+	Region         NativeRegionEnum   `json:"region"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeManagedDisks returns the managed disks, which aren't relics, for the
+// native subscription with the specified RSC native subscription id.
+func (a API) NativeManagedDisks(ctx context.Context, subscriptionID uuid.UUID) ([]NativeManagedDisk, error) {
+	a.log.Print(log.Trace)
+
+	query := azureNativeManagedDisksQuery
+	var disks []NativeManagedDisk
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeManagedDisk `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After          string    `json:"after,omitempty"`
+			SubscriptionID uuid.UUID `json:"subscriptionId"`
+		}{After: cursor, SubscriptionID: subscriptionID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, disk := range payload.Result.Edges {
+			disks = append(disks, disk.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return disks, nil
+}
+
 // Deprecated: no replacement.
 type ProtectionFeature string
 
@@ -133,3 +245,114 @@ func (a API) StartDisableNativeSubscriptionProtectionJob(ctx context.Context, id
 
 	return payload.Data.Result.JobID, nil
 }
+
+// NativeSQLDatabase represents an Azure SQL database in RSC. NewestSnapshot is
+// nil if the database has no snapshots.
+type NativeSQLDatabase struct {
+	ID             uuid.UUID          `json:"id"`
+	NativeID       string             `json:"nativeId"`
+	Name           string             `json:"name"`
+	ServerName     string             `json:"serverName"`
+	Region         NativeRegionEnum   `json:"region"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeSQLDatabases returns the SQL databases, which aren't relics, for the
+// native subscription with the specified RSC native subscription id.
+func (a API) NativeSQLDatabases(ctx context.Context, subscriptionID uuid.UUID) ([]NativeSQLDatabase, error) {
+	a.log.Print(log.Trace)
+
+	query := azureSqlDatabasesQuery
+	var databases []NativeSQLDatabase
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeSQLDatabase `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After          string    `json:"after,omitempty"`
+			SubscriptionID uuid.UUID `json:"subscriptionId"`
+		}{After: cursor, SubscriptionID: subscriptionID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, database := range payload.Result.Edges {
+			databases = append(databases, database.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return databases, nil
+}
+
+// NativeSQLManagedInstanceDatabase represents an Azure SQL managed instance
+// database in RSC. NewestSnapshot is nil if the database has no snapshots.
+type NativeSQLManagedInstanceDatabase struct {
+	ID             uuid.UUID          `json:"id"`
+	NativeID       string             `json:"nativeId"`
+	Name           string             `json:"name"`
+	InstanceName   string             `json:"managedInstanceName"`
+	Region         NativeRegionEnum   `json:"region"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeSQLManagedInstanceDatabases returns the SQL managed instance databases,
+// which aren't relics, for the native subscription with the specified RSC
+// native subscription id.
+func (a API) NativeSQLManagedInstanceDatabases(ctx context.Context, subscriptionID uuid.UUID) ([]NativeSQLManagedInstanceDatabase, error) {
+	a.log.Print(log.Trace)
+
+	query := azureSqlManagedInstanceDatabasesQuery
+	var databases []NativeSQLManagedInstanceDatabase
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeSQLManagedInstanceDatabase `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After          string    `json:"after,omitempty"`
+			SubscriptionID uuid.UUID `json:"subscriptionId"`
+		}{After: cursor, SubscriptionID: subscriptionID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, database := range payload.Result.Edges {
+			databases = append(databases, database.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return databases, nil
+}
@@ -98,6 +98,16 @@ var allAzureCloudAccountTenantsQuery = `query SdkGolangAllAzureCloudAccountTenan
     }
 }`
 
+// allAzureExocomputeConfigManagedIdentities GraphQL query
+var allAzureExocomputeConfigManagedIdentitiesQuery = `query SdkGolangAllAzureExocomputeConfigManagedIdentities($cloudAccountIDs: [UUID!], $azureExocomputeSearchQuery: String!) {
+    result: allAzureExocomputeConfigsInAccount(cloudAccountIDs: $cloudAccountIDs, azureExocomputeSearchQuery: $azureExocomputeSearchQuery) {
+        configs {
+            configUuid
+            managedIdentityNativeId
+        }
+    }
+}`
+
 // allAzureExocomputeConfigsInAccount GraphQL query
 var allAzureExocomputeConfigsInAccountQuery = `query SdkGolangAllAzureExocomputeConfigsInAccount($cloudAccountIDs: [UUID!], $azureExocomputeSearchQuery: String!) {
     result: allAzureExocomputeConfigsInAccount(cloudAccountIDs: $cloudAccountIDs, azureExocomputeSearchQuery: $azureExocomputeSearchQuery) {
@@ -196,6 +206,47 @@ var azureCloudAccountPermissionConfigQuery = `query SdkGolangAzureCloudAccountPe
     }
 }`
 
+// azureNativeManagedDisks GraphQL query
+var azureNativeManagedDisksQuery = `query SdkGolangAzureNativeManagedDisks($after: String, $subscriptionId: String!) {
+    result: azureNativeManagedDisks(after: $after, diskFilters: {
+        subscriptionFilter: {
+            ids: [$subscriptionId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                diskNativeId
+                diskName
+                diskStorageTier
+                diskSizeGib
+                region
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
 // azureNativeSubscriptions GraphQL query
 var azureNativeSubscriptionsQuery = `query SdkGolangAzureNativeSubscriptions($after: String, $filter: String!) {
     result: azureNativeSubscriptions(after: $after, subscriptionFilters: {
@@ -228,6 +279,126 @@ var azureNativeSubscriptionsQuery = `query SdkGolangAzureNativeSubscriptions($af
     }
 }`
 
+// azureNativeVirtualMachines GraphQL query
+var azureNativeVirtualMachinesQuery = `query SdkGolangAzureNativeVirtualMachines($after: String, $subscriptionId: String!) {
+    result: azureNativeVirtualMachines(after: $after, virtualMachineFilters: {
+        subscriptionFilter: {
+            ids: [$subscriptionId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                virtuaMachineId
+                name
+                sizeType
+                region
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// azureSqlDatabases GraphQL query
+var azureSqlDatabasesQuery = `query SdkGolangAzureSqlDatabases($after: String, $subscriptionId: String!) {
+    result: azureSqlDatabases(after: $after, azureSqlDatabaseFilters: {
+        subscriptionFilter: {
+            ids: [$subscriptionId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                name
+                nativeId
+                serverName
+                region
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// azureSqlManagedInstanceDatabases GraphQL query
+var azureSqlManagedInstanceDatabasesQuery = `query SdkGolangAzureSqlManagedInstanceDatabases($after: String, $subscriptionId: String!) {
+    result: azureSqlManagedInstanceDatabases(after: $after, azureSqlManagedInstanceDatabaseFilters: {
+        subscriptionFilter: {
+            ids: [$subscriptionId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                name
+                nativeId
+                managedInstanceName
+                region
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
 // createCloudNativeAzureStorageSetting GraphQL query
 var createCloudNativeAzureStorageSettingQuery = `mutation SdkGolangCreateCloudNativeAzureStorageSetting(
     $cloudAccountId:             UUID!,
@@ -334,6 +505,34 @@ var startDisableAzureNativeSubscriptionProtectionJobQuery = `mutation SdkGolangS
      }
  }`
 
+// startExportAzureNativeVirtualMachineJob GraphQL query
+var startExportAzureNativeVirtualMachineJobQuery = `mutation SdkGolangStartExportAzureNativeVirtualMachineJob($snapshotId: UUID!, $subscriptionId: UUID!, $region: AzureNativeRegion!, $resourceGroupName: String!, $vmName: String!, $vmSize: String!, $subnetNativeId: String!, $shouldPowerOn: Boolean!) {
+    result: startExportAzureNativeVirtualMachineJob(input: {
+        snapshotId:               $snapshotId,
+        targetSubscriptionId:     $subscriptionId,
+        regionName:               $region,
+        resourceGroupName:        $resourceGroupName,
+        vmName:                   $vmName,
+        vmSize:                   $vmSize,
+        subnetNativeId:           $subnetNativeId,
+        shouldPowerOnAfterExport: $shouldPowerOn
+    }) {
+        error
+        jobId
+    }
+}`
+
+// startRestoreAzureNativeVirtualMachineJob GraphQL query
+var startRestoreAzureNativeVirtualMachineJobQuery = `mutation SdkGolangStartRestoreAzureNativeVirtualMachineJob($snapshotId: UUID!, $shouldPowerOn: Boolean!) {
+    result: startRestoreAzureNativeVirtualMachineJob(input: {
+        snapshotId:    $snapshotId,
+        shouldPowerOn: $shouldPowerOn
+    }) {
+        error
+        jobId
+    }
+}`
+
 // unmapAzureCloudAccountExocomputeSubscription GraphQL query
 var unmapAzureCloudAccountExocomputeSubscriptionQuery = `mutation SdkGolangUnmapAzureCloudAccountExocomputeSubscription($cloudAccountIds: [UUID!]!) {
     result: unmapAzureCloudAccountExocomputeSubscription(input: {
@@ -149,26 +149,52 @@ const (
 // RegionFrom parses the value as a region identifier in the specified format.
 // If the value isn't recognized, RegionUnknown is returned.
 func RegionFrom(value string, valueFormat int) Region {
-	for r, info := range regionInfoMap {
-		switch {
-		case (valueFormat == FromAny || valueFormat == FromName) && info.name == value:
-			return r
-		case (valueFormat == FromAny || valueFormat == FromCloudAccountRegionEnum) && info.cloudAccountRegionEnum == value:
-			return r
-		case (valueFormat == FromAny || valueFormat == FromNativeRegionEnum) && info.nativeRegionEnum == value:
-			return r
-		case (valueFormat == FromAny || valueFormat == FromRegionEnum) && info.regionEnum == value:
-			return r
-		case (valueFormat == FromAny || valueFormat == FromDisplayName) && info.displayName == value:
-			return r
-		case (valueFormat == FromAny || valueFormat == FromRegionalDisplayName) && info.regionalDisplayName == value:
-			return r
+	if valueFormat == FromAny {
+		for _, format := range anyFormatOrder {
+			if region, ok := regionLookup[format][value]; ok {
+				return region
+			}
 		}
+		return RegionUnknown
 	}
 
+	if region, ok := regionLookup[valueFormat][value]; ok {
+		return region
+	}
 	return RegionUnknown
 }
 
+// anyFormatOrder is the order in which the formats are tried when parsing a
+// value using FromAny.
+var anyFormatOrder = []int{
+	FromName,
+	FromCloudAccountRegionEnum,
+	FromNativeRegionEnum,
+	FromRegionEnum,
+	FromDisplayName,
+	FromRegionalDisplayName,
+}
+
+// regionLookup maps the values of each format to the region. The maps are
+// built once and only read afterward, which makes lookups safe for concurrent
+// use.
+var regionLookup = func() map[int]map[string]Region {
+	lookup := make(map[int]map[string]Region, len(anyFormatOrder))
+	for _, format := range anyFormatOrder {
+		lookup[format] = make(map[string]Region, len(regionInfoMap))
+	}
+	for region, info := range regionInfoMap {
+		lookup[FromName][info.name] = region
+		lookup[FromCloudAccountRegionEnum][info.cloudAccountRegionEnum] = region
+		lookup[FromNativeRegionEnum][info.nativeRegionEnum] = region
+		lookup[FromRegionEnum][info.regionEnum] = region
+		lookup[FromDisplayName][info.displayName] = region
+		lookup[FromRegionalDisplayName][info.regionalDisplayName] = region
+	}
+
+	return lookup
+}()
+
 // RegionFromAny parses the value as any region identifier that matches.
 func RegionFromAny(value string) Region {
 	return RegionFrom(value, FromAny)
@@ -345,7 +371,7 @@ var regionInfoMap = map[Region]struct {
 		name:                   "centralindia",
 		displayName:            "Central India",
 		regionalDisplayName:    "(Asia Pacific) Central India",
-		regionEnum:             "ISRAEL_CENTRAL",
+		regionEnum:             "CENTRAL_INDIA",
 		cloudAccountRegionEnum: "CENTRALINDIA",
 		nativeRegionEnum:       "CENTRAL_INDIA",
 	},
@@ -751,6 +777,33 @@ var regionInfoMap = map[Region]struct {
 	},
 }
 
+// Cloud returns the Azure cloud the region belongs to.
+func (region Region) Cloud() Cloud {
+	switch region {
+	case RegionChinaEast, RegionChinaEast2, RegionChinaNorth, RegionChinaNorth2:
+		return ChinaCloud
+	case RegionUSGovArizona, RegionUSGovTexas, RegionUSGovVirginia:
+		return USGovernmentCloud
+	default:
+		return PublicCloud
+	}
+}
+
+// ValidateRegions returns an error if any of the regions doesn't belong to the
+// specified Azure cloud. Unknown regions are ignored.
+func ValidateRegions(cloud Cloud, regions []Region) error {
+	for _, region := range regions {
+		if region == RegionUnknown {
+			continue
+		}
+		if regionCloud := region.Cloud(); regionCloud != cloud {
+			return fmt.Errorf("region %s belongs to the %s cloud, not the %s cloud", region.Name(), regionCloud, cloud)
+		}
+	}
+
+	return nil
+}
+
 // Deprecated: use Region.Name.
 func FormatRegion(region Region) string {
 	return region.Name()
@@ -47,3 +47,81 @@ func TestParseRegion(t *testing.T) {
 		t.Errorf("invalid region: %v", regions)
 	}
 }
+
+func TestValidateRegions(t *testing.T) {
+	if err := ValidateRegions(PublicCloud, []Region{RegionEastUS, RegionWestUS3}); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateRegions(ChinaCloud, []Region{RegionChinaEast, RegionChinaNorth2}); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateRegions(USGovernmentCloud, []Region{RegionUSGovVirginia, RegionUSGovArizona}); err != nil {
+		t.Error(err)
+	}
+
+	if err := ValidateRegions(PublicCloud, []Region{RegionEastUS, RegionUSGovTexas}); err == nil {
+		t.Error("government region should be rejected for the public cloud")
+	}
+	if err := ValidateRegions(USGovernmentCloud, []Region{RegionChinaEast}); err == nil {
+		t.Error("china region should be rejected for the government cloud")
+	}
+}
+
+func TestParseCloud(t *testing.T) {
+	for value, expected := range map[string]Cloud{
+		"public":                 PublicCloud,
+		"AZURECHINACLOUD":        ChinaCloud,
+		"usgovernment":           USGovernmentCloud,
+		"AzureUSGovernmentCloud": USGovernmentCloud,
+	} {
+		if cloud, err := ParseCloud(value); err != nil || cloud != expected {
+			t.Errorf("invalid cloud for %q: %v, %v", value, cloud, err)
+		}
+	}
+
+	if _, err := ParseCloud("germany"); err == nil {
+		t.Error("invalid cloud should fail")
+	}
+}
+
+func TestRegionFrom(t *testing.T) {
+	for region, info := range regionInfoMap {
+		if region == RegionUnknown {
+			continue
+		}
+		if r := RegionFromName(info.name); r != region {
+			t.Errorf("invalid region for name %q: %v", info.name, r)
+		}
+		if r := RegionFromCloudAccountRegionEnum(info.cloudAccountRegionEnum); r != region {
+			t.Errorf("invalid region for cloud account region enum %q: %v", info.cloudAccountRegionEnum, r)
+		}
+		if r := RegionFromNativeRegionEnum(info.nativeRegionEnum); r != region {
+			t.Errorf("invalid region for native region enum %q: %v", info.nativeRegionEnum, r)
+		}
+		if r := RegionFromRegionEnum(info.regionEnum); r != region {
+			t.Errorf("invalid region for region enum %q: %v", info.regionEnum, r)
+		}
+		if r := RegionFromAny(info.name); r != region {
+			t.Errorf("invalid region for any %q: %v", info.name, r)
+		}
+	}
+
+	if r := RegionFromName("mars"); r != RegionUnknown {
+		t.Errorf("invalid region: %v", r)
+	}
+	if r := RegionFromAny("mars"); r != RegionUnknown {
+		t.Errorf("invalid region: %v", r)
+	}
+}
+
+func BenchmarkRegionFromName(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		RegionFromName("westus3")
+	}
+}
+
+func BenchmarkRegionFromAny(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		RegionFromAny("(US) West US 3")
+	}
+}
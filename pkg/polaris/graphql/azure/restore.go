@@ -0,0 +1,76 @@
+// Copyright 2021 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package azure
+
+import (
+	"github.com/google/uuid"
+)
+
+// VirtualMachineRestoreTarget restores a virtual machine snapshot in place,
+// replacing the original virtual machine. Use with core.API.RestoreSnapshot.
+type VirtualMachineRestoreTarget struct {
+	PowerOn bool // Power on the virtual machine after the restore.
+}
+
+// RestoreQuery returns the restore query and its parameters.
+func (t VirtualMachineRestoreTarget) RestoreQuery(snapshotID uuid.UUID) (string, any) {
+	return startRestoreAzureNativeVirtualMachineJobQuery, struct {
+		SnapshotID uuid.UUID `json:"snapshotId"`
+		PowerOn    bool      `json:"shouldPowerOn"`
+	}{SnapshotID: snapshotID, PowerOn: t.PowerOn}
+}
+
+// VirtualMachineExportTarget exports a virtual machine snapshot to a new
+// virtual machine. The new virtual machine can be created in a different
+// region and in a different subscription than the original virtual machine.
+// Use with core.API.RestoreSnapshot.
+type VirtualMachineExportTarget struct {
+	SubscriptionID    uuid.UUID // RSC cloud account ID of the destination subscription.
+	Region            Region    // Destination region.
+	ResourceGroupName string
+	VMName            string
+	VMSize            string
+	SubnetNativeID    string
+	PowerOn           bool // Power on the virtual machine after the export.
+}
+
+// RestoreQuery returns the export query and its parameters.
+func (t VirtualMachineExportTarget) RestoreQuery(snapshotID uuid.UUID) (string, any) {
+	return startExportAzureNativeVirtualMachineJobQuery, struct {
+		SnapshotID        uuid.UUID         `json:"snapshotId"`
+		SubscriptionID    uuid.UUID         `json:"subscriptionId"`
+		Region            *NativeRegionEnum `json:"region"`
+		ResourceGroupName string            `json:"resourceGroupName"`
+		VMName            string            `json:"vmName"`
+		VMSize            string            `json:"vmSize"`
+		SubnetNativeID    string            `json:"subnetNativeId"`
+		PowerOn           bool              `json:"shouldPowerOn"`
+	}{
+		SnapshotID:        snapshotID,
+		SubscriptionID:    t.SubscriptionID,
+		Region:            &NativeRegionEnum{Region: t.Region},
+		ResourceGroupName: t.ResourceGroupName,
+		VMName:            t.VMName,
+		VMSize:            t.VMSize,
+		SubnetNativeID:    t.SubnetNativeID,
+		PowerOn:           t.PowerOn,
+	}
+}
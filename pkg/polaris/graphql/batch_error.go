@@ -0,0 +1,100 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package graphql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// BatchError is returned by batch operations when the operation fails for one
+// or more of the objects in the batch. The errors are keyed by the ID of the
+// object the operation failed for. Use errors.Is and errors.As to test for
+// specific errors, or Errors to inspect the failure of each object.
+type BatchError struct {
+	errs map[uuid.UUID]error
+}
+
+// NewBatchError returns a BatchError holding the errors of the specified map,
+// nil errors are ignored. If there are no non-nil errors, nil is returned.
+func NewBatchError(errs map[uuid.UUID]error) error {
+	batchErr := &BatchError{errs: make(map[uuid.UUID]error, len(errs))}
+	for id, err := range errs {
+		if err != nil {
+			batchErr.errs[id] = err
+		}
+	}
+	if len(batchErr.errs) == 0 {
+		return nil
+	}
+
+	return batchErr
+}
+
+// Error returns a summary of the failures, holding the number of failures and
+// the individual errors ordered by object ID.
+func (e *BatchError) Error() string {
+	ids := e.ids()
+	msgs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", id, e.errs[id]))
+	}
+
+	return fmt.Sprintf("%d batch operation(s) failed: %s", len(ids), strings.Join(msgs, "; "))
+}
+
+// Errors returns the errors keyed by the ID of the object the operation failed
+// for. The returned map is a copy and can be modified by the caller.
+func (e *BatchError) Errors() map[uuid.UUID]error {
+	errs := make(map[uuid.UUID]error, len(e.errs))
+	for id, err := range e.errs {
+		errs[id] = err
+	}
+
+	return errs
+}
+
+// Unwrap returns the errors ordered by object ID.
+func (e *BatchError) Unwrap() []error {
+	ids := e.ids()
+	errs := make([]error, 0, len(ids))
+	for _, id := range ids {
+		errs = append(errs, e.errs[id])
+	}
+
+	return errs
+}
+
+// ids returns the object IDs of the errors in sorted order.
+func (e *BatchError) ids() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(e.errs))
+	for id := range e.errs {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return ids
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestBatchError(t *testing.T) {
+	id1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	id2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	id3 := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	err := NewBatchError(map[uuid.UUID]error{
+		id2: fmt.Errorf("account %w", ErrNotFound),
+		id1: context.DeadlineExceeded,
+		id3: nil,
+	})
+	if err == nil {
+		t.Fatal("expected batch error")
+	}
+
+	expected := "2 batch operation(s) failed: 00000000-0000-0000-0000-000000000001: context deadline exceeded; " +
+		"00000000-0000-0000-0000-000000000002: account not found"
+	if msg := err.Error(); msg != expected {
+		t.Errorf("invalid error message: %q", msg)
+	}
+	if !errors.Is(err, ErrNotFound) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("batch error should wrap the individual errors")
+	}
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatal("expected errors.As to find the batch error")
+	}
+	errs := batchErr.Errors()
+	if len(errs) != 2 || errs[id1] != context.DeadlineExceeded {
+		t.Errorf("invalid errors: %v", errs)
+	}
+	delete(errs, id1)
+	if len(batchErr.Errors()) != 2 {
+		t.Error("modifying the returned errors should not modify the batch error")
+	}
+}
+
+func TestBatchErrorNoErrors(t *testing.T) {
+	if err := NewBatchError(nil); err != nil {
+		t.Errorf("expected nil error: %v", err)
+	}
+	if err := NewBatchError(map[uuid.UUID]error{uuid.New(): nil}); err != nil {
+		t.Errorf("expected nil error: %v", err)
+	}
+}
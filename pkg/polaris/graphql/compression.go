@@ -0,0 +1,99 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package graphql
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// compressionThreshold is the smallest request body, in bytes, compressed when
+// compression is enabled. Smaller bodies gain little from being compressed.
+const compressionThreshold = 1024
+
+// SetCompression enables or disables gzip compression of large request bodies.
+// Compression is disabled by default. Responses are always requested with gzip
+// encoding and decompressed transparently, regardless of this setting.
+func (c *Client) SetCompression(enabled bool) {
+	c.compression.Store(enabled)
+}
+
+// compressRequestBody returns the request body gzip compressed, if compression
+// is enabled and the body is large enough to benefit from being compressed.
+// The boolean return value is true if the body was compressed.
+func (c *Client) compressRequestBody(body []byte) ([]byte, bool, error) {
+	if !c.compression.Load() || len(body) < compressionThreshold {
+		return body, false, nil
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(body); err != nil {
+		return nil, false, fmt.Errorf("failed to compress graphql request body: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, false, fmt.Errorf("failed to compress graphql request body: %v", err)
+	}
+
+	return buf.Bytes(), true, nil
+}
+
+// decompressResponse replaces the body of the response with a decompressing
+// reader, if the response body is gzip encoded. Once decompressed, the
+// Content-Encoding and Content-Length headers no longer apply to the body and
+// are removed.
+func decompressResponse(res *http.Response) error {
+	if !strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+
+	r, err := gzip.NewReader(res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to decompress graphql response body (status code %d): %v", res.StatusCode, err)
+	}
+	res.Body = &gzipReadCloser{Reader: r, body: res.Body}
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+
+	return nil
+}
+
+// gzipReadCloser reads a decompressed response body. Closing it closes both
+// the gzip reader and the underlying response body.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (r *gzipReadCloser) Close() error {
+	err := r.Reader.Close()
+	if bodyErr := r.body.Close(); bodyErr != nil {
+		return bodyErr
+	}
+
+	return err
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package graphql
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+func TestCompression(t *testing.T) {
+	client, lis := NewTestClient("john", "doe", log.DiscardLogger{})
+
+	// Respond with a gzip compressed body holding the query received, after
+	// decompressing the request body if it's gzip encoded.
+	var requestCompressed bool
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Accept-Encoding") != "gzip" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body := req.Body
+		requestCompressed = req.Header.Get("Content-Encoding") == "gzip"
+		if requestCompressed {
+			r, err := gzip.NewReader(req.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			body = r
+		}
+		var payload struct {
+			Query string `json:"query"`
+		}
+		if err := json.NewDecoder(body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		gw := gzip.NewWriter(w)
+		defer gw.Close()
+		buf, _ := json.Marshal(payload.Query)
+		io.WriteString(gw, `{"data": {"result": `+string(buf)+`}}`)
+	})
+	defer srv.Shutdown(context.Background())
+
+	var out struct {
+		Result string `json:"result"`
+	}
+
+	// Small request body with compression disabled.
+	query := "query SdkGolangMe { result: me { name } }"
+	if err := client.Execute(context.Background(), query, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Result != query {
+		t.Errorf("invalid result: %q", out.Result)
+	}
+	if requestCompressed {
+		t.Error("request body should not be compressed")
+	}
+
+	// Large request body with compression enabled.
+	client.SetCompression(true)
+	query = "query SdkGolangMe { result: me { name } } # " + strings.Repeat("x", compressionThreshold)
+	if err := client.RequestDecode(context.Background(), query, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Result != query {
+		t.Errorf("invalid result: %q", out.Result)
+	}
+	if !requestCompressed {
+		t.Error("request body should be compressed")
+	}
+
+	// Small request body with compression enabled.
+	query = "query SdkGolangMe { result: me { name } }"
+	if err := client.Execute(context.Background(), query, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if requestCompressed {
+		t.Error("small request body should not be compressed")
+	}
+}
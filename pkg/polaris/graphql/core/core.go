@@ -47,6 +47,29 @@ const (
 	CloudVendorAll   CloudVendor = "ALL_VENDORS"
 )
 
+// CloudAccount is implemented by the AWS, Azure and GCP cloud account types of
+// the high-level cloud packages. It gives access to the fields shared by the
+// cloud accounts of all cloud vendors, the concrete types hold the cloud
+// specific fields.
+type CloudAccount interface {
+	// AccountID returns the RSC cloud account ID.
+	AccountID() uuid.UUID
+
+	// AccountNativeID returns the ID of the cloud account in the cloud, e.g.
+	// the AWS account ID, the Azure subscription ID or the GCP project ID.
+	AccountNativeID() string
+
+	// AccountName returns the name of the cloud account.
+	AccountName() string
+
+	// CloudVendor returns the cloud vendor of the cloud account.
+	CloudVendor() CloudVendor
+
+	// AccountFeatures returns the RSC features onboarded for the cloud
+	// account.
+	AccountFeatures() []Feature
+}
+
 // CloudAccountAction represents a Polaris cloud account action.
 type CloudAccountAction string
 
@@ -182,6 +205,145 @@ var validFeatures = map[string]struct{}{
 	FeatureServerAndApps.Name:                 {},
 }
 
+// IsValid returns true if the feature name is a feature known to the SDK.
+func (feature Feature) IsValid() bool {
+	_, ok := validFeatures[feature.Name]
+	return ok
+}
+
+// ValidFeatureNames returns the sorted names of the features known to the SDK.
+func ValidFeatureNames() []string {
+	names := make([]string, 0, len(validFeatures))
+	for name := range validFeatures {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// validPermissionGroups holds the permission groups known to the SDK to be
+// valid for each feature. RSC may support additional permission groups, so the
+// map is informational and not used to reject permission groups.
+var validPermissionGroups = map[string][]PermissionGroup{
+	FeatureAppFlows.Name:                      {PermissionGroupBasic},
+	FeatureArchival.Name:                      {PermissionGroupBasic},
+	FeatureAzureSQLDBProtection.Name:          {PermissionGroupBasic},
+	FeatureAzureSQLMIProtection.Name:          {PermissionGroupBasic},
+	FeatureCloudNativeArchival.Name:           {PermissionGroupBasic},
+	FeatureCloudNativeArchivalEncryption.Name: {PermissionGroupBasic},
+	FeatureCloudNativeBLOBProtection.Name:     {PermissionGroupBasic},
+	FeatureCloudNativeProtection.Name:         {PermissionGroupBasic},
+	FeatureCloudNativeS3Protection.Name:       {PermissionGroupBasic},
+	FeatureExocompute.Name:                    {PermissionGroupBasic, PermissionGroupRSCManagedCluster},
+	FeatureGCPSharedVPCHost.Name:              {PermissionGroupBasic},
+	FeatureKubernetesProtection.Name:          {PermissionGroupBasic},
+	FeatureRDSProtection.Name:                 {PermissionGroupBasic},
+	FeatureServerAndApps.Name:                 {PermissionGroupBasic},
+}
+
+// ValidPermissionGroups returns the permission groups known to the SDK to be
+// valid for the feature. Returns an empty slice if no permission groups are
+// known for the feature. Note, RSC may support additional permission groups.
+func (feature Feature) ValidPermissionGroups() []PermissionGroup {
+	return slices.Clone(validPermissionGroups[feature.Name])
+}
+
+// ValidateFeatures returns an error if any of the features isn't known to the
+// SDK or has a permission group which is known to be invalid, i.e. an empty or
+// unspecified permission group, or a permission group for FeatureAll, which
+// isn't an onboardable feature. Other permission groups are passed through to
+// RSC, which has the final say on which combinations are valid.
+func ValidateFeatures(features ...Feature) error {
+	for _, feature := range features {
+		if !feature.IsValid() {
+			return fmt.Errorf("invalid feature %q, valid features are: %s", feature.Name,
+				strings.Join(ValidFeatureNames(), ", "))
+		}
+
+		for _, permissionGroup := range feature.PermissionGroups {
+			if permissionGroup == "" || permissionGroup == PermissionGroupInvalid {
+				return fmt.Errorf("invalid permission group %q for feature %s", permissionGroup, feature.Name)
+			}
+			if feature.Name == FeatureAll.Name {
+				return fmt.Errorf("invalid permission group %q for feature %s, the feature doesn't support permission groups",
+					permissionGroup, feature.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// featureParents holds the parent feature of each child feature. A child
+// feature can only be onboarded when its parent feature is onboarded, and
+// must be removed before its parent feature.
+var featureParents = map[string]Feature{
+	FeatureCloudNativeArchivalEncryption.Name: FeatureCloudNativeArchival,
+}
+
+// Parent returns the parent feature of the feature. False is returned if the
+// feature isn't a child feature.
+func (feature Feature) Parent() (Feature, bool) {
+	parent, ok := featureParents[feature.Name]
+	return parent, ok
+}
+
+// Children returns the child features of the feature, sorted by name. Returns
+// an empty slice if the feature has no child features.
+func (feature Feature) Children() []Feature {
+	var children []Feature
+	for name, parent := range featureParents {
+		if parent.Equal(feature) {
+			children = append(children, Feature{Name: name})
+		}
+	}
+	slices.SortFunc(children, func(a, b Feature) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return children
+}
+
+// ValidateFeatureParents returns an error if any of the features is a child
+// feature whose parent feature is neither one of the features nor one of the
+// onboarded features.
+func ValidateFeatureParents(features, onboarded []Feature) error {
+	for _, feature := range features {
+		parent, ok := feature.Parent()
+		if !ok {
+			continue
+		}
+		if !slices.ContainsFunc(features, parent.Equal) && !slices.ContainsFunc(onboarded, parent.Equal) {
+			return fmt.Errorf("feature %s requires its parent feature %s to be onboarded", feature.Name, parent.Name)
+		}
+	}
+
+	return nil
+}
+
+// featureDepth returns the number of ancestors of the feature.
+func featureDepth(feature Feature) int {
+	depth := 0
+	for parent, ok := featureParents[feature.Name]; ok; parent, ok = featureParents[parent.Name] {
+		depth++
+	}
+
+	return depth
+}
+
+// FeatureRemovalOrder returns the features sorted in the order they should be
+// removed, child features before their parent features. Features without a
+// parent child relationship keep their relative order.
+func FeatureRemovalOrder(features []Feature) []Feature {
+	ordered := slices.Clone(features)
+	slices.SortStableFunc(ordered, func(a, b Feature) int {
+		return featureDepth(b) - featureDepth(a)
+	})
+
+	return ordered
+}
+
 // ContainsFeature returns true if the features slice contains the specified
 // feature.
 func ContainsFeature(features []Feature, feature Feature) bool {
@@ -204,7 +366,7 @@ func FormatFeature(feature Feature) string {
 // names.
 func ParseFeature(feature string) (Feature, error) {
 	f := ParseFeatureNoValidation(feature)
-	if _, ok := validFeatures[f.Name]; ok {
+	if f.IsValid() {
 		return f, nil
 	}
 
@@ -223,20 +385,53 @@ const (
 	waitAttempts = 50
 )
 
-// Status represents a Polaris cloud account status.
-type Status string
+// FeatureStatus represents the status of an RSC cloud account feature.
+type FeatureStatus string
+
+// Status is the previous name of FeatureStatus.
+//
+// Deprecated: use FeatureStatus.
+type Status = FeatureStatus
 
 const (
-	StatusConnected          Status = "CONNECTED"
-	StatusConnecting         Status = "CONNECTING"
-	StatusDisabled           Status = "DISABLED"
-	StatusDisconnected       Status = "DISCONNECTED"
-	StatusMissingPermissions Status = "MISSING_PERMISSIONS"
+	StatusConnected          FeatureStatus = "CONNECTED"
+	StatusConnecting         FeatureStatus = "CONNECTING"
+	StatusDisabled           FeatureStatus = "DISABLED"
+	StatusDisconnected       FeatureStatus = "DISCONNECTED"
+	StatusMissingPermissions FeatureStatus = "MISSING_PERMISSIONS"
 )
 
+// IsHealthy returns true if the feature is connected.
+func (status FeatureStatus) IsHealthy() bool {
+	return status == StatusConnected
+}
+
+// IsTransitioning returns true if the feature is changing state, e.g. while
+// a feature is being onboarded.
+func (status FeatureStatus) IsTransitioning() bool {
+	return status == StatusConnecting
+}
+
+// IsFailed returns true if the feature is in a failure state which won't
+// change without further action, e.g. missing permissions.
+func (status FeatureStatus) IsFailed() bool {
+	switch status {
+	case StatusDisconnected, StatusMissingPermissions:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTerminal returns true if the feature is in a state which won't change
+// without further action, i.e. the feature is not transitioning.
+func (status FeatureStatus) IsTerminal() bool {
+	return status.IsHealthy() || status == StatusDisabled || status.IsFailed()
+}
+
 // FormatStatus returns the Status as a string using lower-case and with hyphen
 // as a separator.
-func FormatStatus(status Status) string {
+func FormatStatus(status FeatureStatus) string {
 	return strings.ReplaceAll(strings.ToLower(string(status)), "_", "-")
 }
 
@@ -263,12 +458,42 @@ const (
 	Unassigned SLAAssignment = "Unassigned"
 )
 
+// Polaris uses special SLA domain ids for objects which aren't protected by
+// an SLA domain.
+const (
+	UnprotectedSLADomainID  = "UNPROTECTED"
+	DoNotProtectSLADomainID = "DO_NOT_PROTECT"
+)
+
 // SLADomain represents a Polaris SLA domain.
 type SLADomain struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
 }
 
+// Protects returns true if the SLA domain protects the objects it's assigned
+// to, i.e., the SLA domain isn't one of the special unprotected or do not
+// protect SLA domains.
+func (d SLADomain) Protects() bool {
+	return d.ID != "" && d.ID != UnprotectedSLADomainID && d.ID != DoNotProtectSLADomainID
+}
+
+// Snapshot represents a reference to a Polaris snapshot.
+type Snapshot struct {
+	ID   uuid.UUID `json:"id"`
+	Date time.Time `json:"date"`
+}
+
+// Time returns the date of the snapshot or the zero time if there is no
+// snapshot, i.e. the snapshot is nil.
+func (snapshot *Snapshot) Time() time.Time {
+	if snapshot == nil {
+		return time.Time{}
+	}
+
+	return snapshot.Date
+}
+
 // API wraps around GraphQL clients to give them the Polaris Core API.
 type API struct {
 	Version string // Deprecated: use GQL.DeploymentVersion
@@ -396,6 +621,41 @@ func (a API) WaitForFeatureDisableTaskChain(ctx context.Context, taskChainID uui
 	}
 }
 
+// WaitForFeatureStatus blocks until the feature status returned by the
+// featureStatus function is equal to the specified status. If the feature
+// reaches a terminal status other than the specified status, an error is
+// returned. Note, the terminal status the feature is in when the wait starts
+// is not considered an error unless it's a failure status, e.g. a feature
+// being disabled is still CONNECTED for a while. The wait parameter specifies
+// the amount of time to wait before requesting another feature status update.
+func (a API) WaitForFeatureStatus(ctx context.Context, status FeatureStatus, wait time.Duration, featureStatus func(ctx context.Context) (FeatureStatus, error)) error {
+	a.log.Print(log.Trace)
+
+	var initial FeatureStatus
+	for {
+		current, err := featureStatus(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve feature status: %w", err)
+		}
+		if current == status {
+			return nil
+		}
+		if initial == "" {
+			initial = current
+		}
+		if current.IsFailed() || (current.IsTerminal() && current != initial) {
+			return fmt.Errorf("feature reached terminal status %s while waiting for status %s", current, status)
+		}
+
+		a.log.Printf(log.Debug, "Waiting for feature status %s, current status is %s", status, current)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // Deprecated: use GQL.DeploymentVersion.
 func (a API) DeploymentVersion(ctx context.Context) (string, error) {
 	a.log.Print(log.Trace)
@@ -467,3 +727,66 @@ func (a API) EnabledFeaturesForAccount(ctx context.Context) ([]Feature, error) {
 
 	return features, nil
 }
+
+// cloudFeatures holds the cloud account features supported by each cloud
+// vendor.
+var cloudFeatures = map[CloudVendor][]Feature{
+	CloudVendorAWS: {
+		FeatureAppFlows,
+		FeatureCloudNativeArchival,
+		FeatureCloudNativeArchivalEncryption,
+		FeatureCloudNativeProtection,
+		FeatureCloudNativeS3Protection,
+		FeatureExocompute,
+		FeatureKubernetesProtection,
+		FeatureRDSProtection,
+		FeatureServerAndApps,
+	},
+	CloudVendorAzure: {
+		FeatureAzureSQLDBProtection,
+		FeatureAzureSQLMIProtection,
+		FeatureCloudNativeArchival,
+		FeatureCloudNativeArchivalEncryption,
+		FeatureCloudNativeBLOBProtection,
+		FeatureCloudNativeProtection,
+		FeatureExocompute,
+		FeatureKubernetesProtection,
+		FeatureServerAndApps,
+	},
+	CloudVendorGCP: {
+		FeatureCloudNativeProtection,
+		FeatureGCPSharedVPCHost,
+	},
+}
+
+// AvailableFeatures returns the cloud account features available for the
+// specified cloud vendor in the RSC account. Which features are enabled for
+// the RSC account is queried from RSC, but which features a cloud vendor
+// supports and the permission groups of each feature are taken from static
+// tables in the SDK, RSC has no cloud agnostic query for them. So a feature or
+// permission group added to RSC after the SDK release is not returned. The
+// features are returned without permission group versions, the versions are
+// cloud specific and can be looked up using the cloud specific permission
+// APIs, e.g., azure.API.ScopedPermissions.
+func (a API) AvailableFeatures(ctx context.Context, cloud CloudVendor) ([]Feature, error) {
+	a.log.Print(log.Trace)
+
+	features, ok := cloudFeatures[cloud]
+	if !ok {
+		return nil, fmt.Errorf("invalid cloud vendor: %s", cloud)
+	}
+
+	enabledFeatures, err := a.EnabledFeaturesForAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var available []Feature
+	for _, feature := range features {
+		if ContainsFeature(enabledFeatures, feature) {
+			available = append(available, feature.WithPermissionGroups(feature.ValidPermissionGroups()...))
+		}
+	}
+
+	return available, nil
+}
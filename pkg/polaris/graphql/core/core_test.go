@@ -23,8 +23,11 @@ package core
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
+	"reflect"
+	"strings"
 	"testing"
 	"text/template"
 	"time"
@@ -156,3 +159,433 @@ func TestWaitForTaskChain(t *testing.T) {
 		t.Errorf("invalid task chain state: %v", state)
 	}
 }
+
+type testRestoreTarget struct{}
+
+func (testRestoreTarget) RestoreQuery(snapshotID uuid.UUID) (string, any) {
+	return "mutation RubrikPolarisSDKRequest($snapshotId: UUID!) { result: testRestore(snapshotId: $snapshotId) { error jobId } }", struct {
+		SnapshotID uuid.UUID `json:"snapshotId"`
+	}{SnapshotID: snapshotID}
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	client, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	coreAPI := Wrap(client)
+
+	snapshotID := uuid.MustParse("6a1bd29c-6d4c-4f4b-a8f4-c1f7a6e3f0b5")
+	jobID := uuid.MustParse("b48e7ad0-7b86-4c96-b6ba-97eb6a82f765")
+
+	// Respond with the job id if the snapshot id matches, otherwise respond
+	// with an error.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		var payload struct {
+			Variables struct {
+				SnapshotID uuid.UUID `json:"snapshotId"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), 500)
+			return
+		}
+
+		var result struct {
+			Error string `json:"error"`
+			JobID string `json:"jobId"`
+		}
+		if payload.Variables.SnapshotID == snapshotID {
+			result.JobID = jobID.String()
+		} else {
+			result.Error = "snapshot not found"
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"result": result}})
+	})
+	defer srv.Shutdown(context.Background())
+
+	id, err := coreAPI.RestoreSnapshot(context.Background(), snapshotID, testRestoreTarget{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != jobID {
+		t.Errorf("invalid job id: %v", id)
+	}
+
+	if _, err := coreAPI.RestoreSnapshot(context.Background(), uuid.New(), testRestoreTarget{}); err == nil {
+		t.Error("expected restore of unknown snapshot to fail")
+	}
+
+	if _, err := coreAPI.RestoreSnapshot(context.Background(), snapshotID, nil); err == nil {
+		t.Error("expected restore with nil target to fail")
+	}
+}
+
+func TestAssignSLAForSnappableHierarchies(t *testing.T) {
+	client, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	coreAPI := Wrap(client)
+
+	slaID := uuid.MustParse("0a1d3ad1-8a2b-4d7c-9d38-7f6cb1d3e2f4")
+	failID := uuid.MustParse("e4a8b1c2-3d4e-4f5a-8b6c-7d8e9f0a1b2c")
+
+	// Respond with one result per object, failing the assignment for the
+	// object with the fail id.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		var payload struct {
+			Variables struct {
+				SLAID      *uuid.UUID    `json:"slaId"`
+				AssignType SLAAssignType `json:"assignType"`
+				ObjectIDs  []uuid.UUID   `json:"objectIds"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), 500)
+			return
+		}
+		if payload.Variables.AssignType == ProtectWithSLAID && (payload.Variables.SLAID == nil || *payload.Variables.SLAID != slaID) {
+			http.Error(w, "invalid sla id", 400)
+			return
+		}
+
+		var result []map[string]bool
+		for _, id := range payload.Variables.ObjectIDs {
+			result = append(result, map[string]bool{"success": id != failID})
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"result": result}})
+	})
+	defer srv.Shutdown(context.Background())
+
+	objectIDs := []uuid.UUID{uuid.New(), failID}
+	results, err := coreAPI.AssignSLAForSnappableHierarchies(context.Background(), &slaID, ProtectWithSLAID, objectIDs, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []SLAAssignResult{{ObjectID: objectIDs[0], Success: true}, {ObjectID: failID, Success: false}}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("invalid results: %v", results)
+	}
+
+	results, err = coreAPI.ProtectObjects(context.Background(), objectIDs[:1], slaID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(results, expected[:1]) {
+		t.Errorf("invalid results: %v", results)
+	}
+	if _, err := coreAPI.ProtectObjects(context.Background(), objectIDs, uuid.Nil, false); err == nil {
+		t.Error("expected protect with nil sla domain id to fail")
+	}
+
+	results, err = coreAPI.UnprotectObjects(context.Background(), objectIDs[1:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(results, expected[1:]) {
+		t.Errorf("invalid results: %v", results)
+	}
+
+	// No request should be made when there are no objects.
+	results, err = coreAPI.UnprotectObjects(context.Background(), nil)
+	if err != nil || len(results) != 0 {
+		t.Errorf("invalid results: %v, %v", results, err)
+	}
+
+	if err := coreAPI.AssignSLADomain(context.Background(), slaID, objectIDs[:1], false); err != nil {
+		t.Errorf("assignment should succeed: %s", err)
+	}
+	err = coreAPI.AssignSLADomain(context.Background(), slaID, objectIDs, false)
+	var batchErr *graphql.BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected batch error, got: %v", err)
+	}
+	if errs := batchErr.Errors(); len(errs) != 1 || errs[failID] == nil {
+		t.Errorf("invalid batch errors: %v", errs)
+	}
+	err = coreAPI.UnassignSLADomain(context.Background(), objectIDs[1:])
+	if !errors.As(err, &batchErr) {
+		t.Errorf("expected batch error, got: %v", err)
+	}
+}
+
+func TestSnapshotTime(t *testing.T) {
+	var snapshot *Snapshot
+	if date := snapshot.Time(); !date.IsZero() {
+		t.Errorf("invalid time of nil snapshot: %v", date)
+	}
+
+	date := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	snapshot = &Snapshot{ID: uuid.New(), Date: date}
+	if !snapshot.Time().Equal(date) {
+		t.Errorf("invalid snapshot time: %v", snapshot.Time())
+	}
+}
+
+func TestObjectProtectionStatus(t *testing.T) {
+	client, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	coreAPI := Wrap(client)
+
+	fid := uuid.MustParse("4f0a9d2e-5b1c-4a3d-8e7f-6a5b4c3d2e1f")
+
+	// Respond with the object if the fid matches, otherwise respond with a
+	// null object.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		var payload struct {
+			Variables struct {
+				FID uuid.UUID `json:"fid"`
+				ID  string    `json:"id"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), 500)
+			return
+		}
+		if payload.Variables.FID.String() != payload.Variables.ID {
+			http.Error(w, "id mismatch", 400)
+			return
+		}
+
+		data := map[string]any{"object": nil, "snappables": map[string]any{"nodes": []any{}}}
+		if payload.Variables.FID == fid {
+			data["object"] = map[string]any{
+				"id":                  fid,
+				"name":                "my-instance",
+				"objectType":          "Ec2Instance",
+				"slaAssignment":       "Derived",
+				"configuredSlaDomain": map[string]string{"id": "INHERIT", "name": "INHERIT"},
+				"effectiveSlaDomain":  map[string]string{"id": "d8e4b0a2-1c3f-4e5d-9a7b-6c5d4e3f2a1b", "name": "gold"},
+			}
+			data["snappables"] = map[string]any{"nodes": []any{map[string]any{
+				"complianceStatus": "IN_COMPLIANCE",
+				"lastSnapshot":     "2024-05-01T10:00:00Z",
+			}}}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": data})
+	})
+	defer srv.Shutdown(context.Background())
+
+	protection, err := coreAPI.ObjectProtectionStatus(context.Background(), fid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if protection.ID != fid || protection.Name != "my-instance" {
+		t.Errorf("invalid object: %v", protection)
+	}
+	if !protection.Protected() || !protection.Inherited() {
+		t.Errorf("invalid protection: %v", protection)
+	}
+	if protection.ComplianceStatus != ComplianceStatusInCompliance {
+		t.Errorf("invalid compliance status: %v", protection.ComplianceStatus)
+	}
+	if !protection.LastSnapshot.Equal(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("invalid last snapshot: %v", protection.LastSnapshot)
+	}
+
+	if _, err := coreAPI.ObjectProtectionStatus(context.Background(), uuid.New()); !errors.Is(err, graphql.ErrNotFound) {
+		t.Errorf("expected not found error: %v", err)
+	}
+}
+
+func TestValidateFeatures(t *testing.T) {
+	if err := ValidateFeatures(FeatureCloudNativeProtection, FeatureExocompute.WithPermissionGroups(PermissionGroupBasic)); err != nil {
+		t.Errorf("features should be valid: %s", err)
+	}
+
+	err := ValidateFeatures(FeatureCloudNativeProtection, Feature{Name: "CYBER_RECOVERY_DATA_CLASSIFICATION_DATA"})
+	if err == nil {
+		t.Fatal("feature should be invalid")
+	}
+	if !strings.Contains(err.Error(), FeatureCloudNativeProtection.Name) {
+		t.Errorf("error should list the valid features: %s", err)
+	}
+}
+
+func TestValidateFeaturesPermissionGroups(t *testing.T) {
+	valid := []Feature{
+		FeatureCloudNativeProtection,
+		FeatureCloudNativeProtection.WithPermissionGroups(PermissionGroupBasic),
+		FeatureExocompute.WithPermissionGroups(PermissionGroupBasic, PermissionGroupRSCManagedCluster),
+		FeatureExocompute.WithPermissionGroups(PermissionGroupRSCManagedCluster),
+		// Permission groups unknown to the SDK are left for RSC to validate.
+		FeatureCloudNativeProtection.WithPermissionGroups("FUTURE_GROUP"),
+	}
+	for _, feature := range valid {
+		if err := ValidateFeatures(feature); err != nil {
+			t.Errorf("feature %s should be valid: %s", feature, err)
+		}
+	}
+
+	invalid := []Feature{
+		FeatureCloudNativeProtection.WithPermissionGroups(PermissionGroupInvalid),
+		FeatureExocompute.WithPermissionGroups(PermissionGroupBasic, ""),
+		FeatureAll.WithPermissionGroups(PermissionGroupBasic),
+	}
+	for _, feature := range invalid {
+		if err := ValidateFeatures(feature); err == nil {
+			t.Errorf("feature %s should be invalid", feature)
+		}
+	}
+}
+
+func TestFeatureRemovalOrder(t *testing.T) {
+	features := FeatureRemovalOrder([]Feature{
+		FeatureCloudNativeArchival,
+		FeatureCloudNativeProtection,
+		FeatureCloudNativeArchivalEncryption,
+		FeatureExocompute,
+	})
+	if names := FeatureNames(features); !reflect.DeepEqual(names, []string{
+		FeatureCloudNativeArchivalEncryption.Name,
+		FeatureCloudNativeArchival.Name,
+		FeatureCloudNativeProtection.Name,
+		FeatureExocompute.Name,
+	}) {
+		t.Errorf("invalid removal order: %v", names)
+	}
+
+	if features := FeatureRemovalOrder(nil); len(features) != 0 {
+		t.Errorf("invalid removal order: %v", features)
+	}
+}
+
+func TestFeatureParentChildren(t *testing.T) {
+	if parent, ok := FeatureCloudNativeArchivalEncryption.Parent(); !ok || !parent.Equal(FeatureCloudNativeArchival) {
+		t.Errorf("invalid parent: %v, %t", parent, ok)
+	}
+	if _, ok := FeatureCloudNativeArchival.Parent(); ok {
+		t.Error("feature should not have a parent")
+	}
+
+	children := FeatureCloudNativeArchival.Children()
+	if len(children) != 1 || !children[0].Equal(FeatureCloudNativeArchivalEncryption) {
+		t.Errorf("invalid children: %v", children)
+	}
+	if children := FeatureExocompute.Children(); len(children) != 0 {
+		t.Errorf("invalid children: %v", children)
+	}
+}
+
+func TestValidateFeatureParents(t *testing.T) {
+	if err := ValidateFeatureParents([]Feature{FeatureCloudNativeArchival, FeatureCloudNativeArchivalEncryption}, nil); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateFeatureParents([]Feature{FeatureCloudNativeArchivalEncryption}, []Feature{FeatureCloudNativeArchival}); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateFeatureParents([]Feature{FeatureCloudNativeProtection}, nil); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateFeatureParents([]Feature{FeatureCloudNativeArchivalEncryption}, []Feature{FeatureExocompute}); err == nil {
+		t.Error("child feature without its parent feature should fail")
+	}
+}
+
+func TestAvailableFeatures(t *testing.T) {
+	client, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	coreAPI := Wrap(client)
+
+	// Respond with status code 200 and a valid body.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"data": {"result": {"features": ["CLOUD_NATIVE_PROTECTION", "EXOCOMPUTE", "AZURE_SQL_DB_PROTECTION"]}}}`))
+	})
+	defer srv.Shutdown(context.Background())
+
+	features, err := coreAPI.AvailableFeatures(context.Background(), CloudVendorAWS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Feature{
+		FeatureCloudNativeProtection.WithPermissionGroups(PermissionGroupBasic),
+		FeatureExocompute.WithPermissionGroups(PermissionGroupBasic, PermissionGroupRSCManagedCluster),
+	}
+	if len(features) != len(expected) {
+		t.Fatalf("invalid number of features: %d", len(features))
+	}
+	for i, feature := range features {
+		if !feature.DeepEqual(expected[i]) {
+			t.Errorf("invalid feature: %s", feature)
+		}
+	}
+
+	if _, err := coreAPI.AvailableFeatures(context.Background(), CloudVendorAll); err == nil {
+		t.Error("expected invalid cloud vendor to fail")
+	}
+}
+
+func TestFeatureStatus(t *testing.T) {
+	if !StatusConnected.IsHealthy() || StatusConnected.IsTransitioning() || StatusConnected.IsFailed() {
+		t.Errorf("invalid classification of %s", StatusConnected)
+	}
+	if StatusConnecting.IsHealthy() || !StatusConnecting.IsTransitioning() || StatusConnecting.IsFailed() {
+		t.Errorf("invalid classification of %s", StatusConnecting)
+	}
+	if StatusDisabled.IsHealthy() || StatusDisabled.IsTransitioning() || StatusDisabled.IsFailed() {
+		t.Errorf("invalid classification of %s", StatusDisabled)
+	}
+	for _, status := range []FeatureStatus{StatusDisconnected, StatusMissingPermissions} {
+		if status.IsHealthy() || status.IsTransitioning() || !status.IsFailed() {
+			t.Errorf("invalid classification of %s", status)
+		}
+	}
+	for _, status := range []FeatureStatus{StatusConnected, StatusDisabled, StatusDisconnected, StatusMissingPermissions} {
+		if !status.IsTerminal() {
+			t.Errorf("%s should be terminal", status)
+		}
+	}
+	if StatusConnecting.IsTerminal() {
+		t.Errorf("%s should not be terminal", StatusConnecting)
+	}
+}
+
+func TestWaitForFeatureStatus(t *testing.T) {
+	client, _ := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	coreAPI := Wrap(client)
+
+	statuses := []FeatureStatus{StatusConnecting, StatusConnecting, StatusConnected}
+	err := coreAPI.WaitForFeatureStatus(context.Background(), StatusConnected, time.Millisecond, func(ctx context.Context) (FeatureStatus, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		return status, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 0 {
+		t.Errorf("invalid number of status updates: %d remaining", len(statuses))
+	}
+
+	// Disabling a feature starts out with the feature still connected.
+	statuses = []FeatureStatus{StatusConnected, StatusConnected, StatusDisabled}
+	err = coreAPI.WaitForFeatureStatus(context.Background(), StatusDisabled, time.Millisecond, func(ctx context.Context) (FeatureStatus, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		return status, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 0 {
+		t.Errorf("invalid number of status updates: %d remaining", len(statuses))
+	}
+
+	err = coreAPI.WaitForFeatureStatus(context.Background(), StatusConnected, time.Millisecond, func(ctx context.Context) (FeatureStatus, error) {
+		return StatusMissingPermissions, nil
+	})
+	if err == nil {
+		t.Error("failure status should fail the wait")
+	}
+
+	statuses = []FeatureStatus{StatusConnecting, StatusDisabled}
+	err = coreAPI.WaitForFeatureStatus(context.Background(), StatusConnected, time.Millisecond, func(ctx context.Context) (FeatureStatus, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		return status, nil
+	})
+	if err == nil {
+		t.Error("reaching a terminal status other than the initial status should fail the wait")
+	}
+
+	errStatus := errors.New("status error")
+	err = coreAPI.WaitForFeatureStatus(context.Background(), StatusConnected, time.Millisecond, func(ctx context.Context) (FeatureStatus, error) {
+		return "", errStatus
+	})
+	if !errors.Is(err, errStatus) {
+		t.Errorf("expected wrapped status error, got: %v", err)
+	}
+}
@@ -36,6 +36,18 @@ var allEnabledFeaturesForAccountQuery = `query SdkGolangAllEnabledFeaturesForAcc
     }
 }`
 
+// assignSlasForSnappableHierarchies GraphQL query
+var assignSlasForSnappableHierarchiesQuery = `mutation SdkGolangAssignSlasForSnappableHierarchies($slaId: UUID, $assignType: SlaAssignTypeEnum!, $objectIds: [UUID!]!, $applyToExistingSnapshots: Boolean) {
+    result: assignSlasForSnappableHierarchies(
+        globalSlaOptionalFid:           $slaId,
+        globalSlaAssignType:            $assignType,
+        objectIds:                      $objectIds,
+        shouldApplyToExistingSnapshots: $applyToExistingSnapshots,
+    ) {
+        success
+    }
+}`
+
 // deploymentVersion GraphQL query
 var deploymentVersionQuery = `query SdkGolangDeploymentVersion {
     deploymentVersion
@@ -51,3 +63,27 @@ var getKorgTaskchainStatusQuery = `query SdkGolangGetKorgTaskchainStatus($taskch
         }
     }
 }`
+
+// objectProtectionStatus GraphQL query
+var objectProtectionStatusQuery = `query SdkGolangObjectProtectionStatus($fid: UUID!, $id: String!) {
+    object: hierarchyObject(fid: $fid) {
+        id
+        name
+        objectType
+        slaAssignment
+        configuredSlaDomain {
+            id
+            name
+        }
+        effectiveSlaDomain {
+            id
+            name
+        }
+    }
+    snappables: snappableConnection(filter: {id: [$id]}) {
+        nodes {
+            complianceStatus
+            lastSnapshot
+        }
+    }
+}`
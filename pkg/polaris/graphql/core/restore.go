@@ -0,0 +1,77 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// RestoreTarget describes where a snapshot should be restored to. Restore
+// targets are provided by the cloud specific packages, e.g. graphql/aws, and
+// either restore the snapshot in place, replacing the original object, or
+// export the snapshot to a new object. Targets exist for AWS EC2 instances and
+// Azure virtual machines, there are no targets for GCP GCE instances yet.
+//
+// TODO: add GCE instance restore and export targets to graphql/gcp.
+type RestoreTarget interface {
+	RestoreQuery(snapshotID uuid.UUID) (string, any)
+}
+
+// RestoreSnapshot starts a job restoring the snapshot with the specified id to
+// the specified restore target. Returns the RSC task chain id of the restore
+// job, which can be passed to WaitForTaskChain.
+func (a API) RestoreSnapshot(ctx context.Context, snapshotID uuid.UUID, target RestoreTarget) (uuid.UUID, error) {
+	a.log.Print(log.Trace)
+
+	if target == nil {
+		return uuid.Nil, errors.New("restore target is not allowed to be nil")
+	}
+
+	query, params := target.RestoreQuery(snapshotID)
+	buf, err := a.GQL.Request(ctx, query, params)
+	if err != nil {
+		return uuid.Nil, graphql.RequestError(query, err)
+	}
+	graphql.LogResponse(a.log, query, buf)
+
+	var payload struct {
+		Data struct {
+			Result struct {
+				Error string    `json:"error"`
+				JobID uuid.UUID `json:"jobId"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(buf, &payload); err != nil {
+		return uuid.Nil, graphql.UnmarshalError(query, err)
+	}
+	if payload.Data.Result.Error != "" {
+		return uuid.Nil, graphql.ResponseError(query, errors.New(payload.Data.Result.Error))
+	}
+
+	return payload.Data.Result.JobID, nil
+}
@@ -0,0 +1,270 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// SLAAssignType represents the type of SLA domain assignment to perform.
+type SLAAssignType string
+
+const (
+	// ProtectWithSLAID assigns the specified SLA domain to the objects.
+	ProtectWithSLAID SLAAssignType = "protectWithSlaId"
+
+	// DoNotProtect marks the objects as not to be protected, overriding any
+	// SLA domain inherited from the object hierarchy.
+	DoNotProtect SLAAssignType = "doNotProtect"
+
+	// NoAssignment removes the direct SLA domain assignment of the objects,
+	// letting them inherit the SLA domain of their parent.
+	NoAssignment SLAAssignType = "noAssignment"
+)
+
+// SLAAssignResult holds the result of an SLA domain assignment for a single
+// object.
+type SLAAssignResult struct {
+	ObjectID uuid.UUID
+	Success  bool
+}
+
+// ProtectObjects protects the objects with the specified ids using the SLA
+// domain with the specified id. The object ids can be of any object type
+// supporting SLA domain assignment. When applyToExisting is true, the SLA
+// domain is also applied to the existing snapshots of the objects. Returns
+// the result of the assignment for each object.
+func (a API) ProtectObjects(ctx context.Context, objectIDs []uuid.UUID, slaID uuid.UUID, applyToExisting bool) ([]SLAAssignResult, error) {
+	a.log.Print(log.Trace)
+
+	if slaID == uuid.Nil {
+		return nil, errors.New("sla domain id is not allowed to be nil")
+	}
+
+	return a.AssignSLAForSnappableHierarchies(ctx, &slaID, ProtectWithSLAID, objectIDs, applyToExisting)
+}
+
+// UnprotectObjects marks the objects with the specified ids as not to be
+// protected, overriding any SLA domain inherited from the object hierarchy.
+// The existing snapshots of the objects are kept according to the SLA domain
+// that created them. Returns the result of the assignment for each object.
+func (a API) UnprotectObjects(ctx context.Context, objectIDs []uuid.UUID) ([]SLAAssignResult, error) {
+	a.log.Print(log.Trace)
+
+	return a.AssignSLAForSnappableHierarchies(ctx, nil, DoNotProtect, objectIDs, false)
+}
+
+// AssignSLADomain assigns the SLA domain with the specified id to the objects
+// with the specified ids. When applyToExisting is true, the SLA domain is also
+// applied to the existing snapshots of the objects. If the assignment fails
+// for some of the objects, a *graphql.BatchError is returned.
+func (a API) AssignSLADomain(ctx context.Context, slaDomainID uuid.UUID, objectIDs []uuid.UUID, applyToExisting bool) error {
+	a.log.Print(log.Trace)
+
+	results, err := a.ProtectObjects(ctx, objectIDs, slaDomainID, applyToExisting)
+	if err != nil {
+		return fmt.Errorf("failed to assign sla domain: %s", err)
+	}
+
+	return SLAAssignError(results)
+}
+
+// UnassignSLADomain removes the SLA domain directly assigned to the objects
+// with the specified ids. The objects will inherit the SLA domain of their
+// parent, e.g. the cloud account, if any. If the removal fails for some of the
+// objects, a *graphql.BatchError is returned.
+func (a API) UnassignSLADomain(ctx context.Context, objectIDs []uuid.UUID) error {
+	a.log.Print(log.Trace)
+
+	results, err := a.AssignSLAForSnappableHierarchies(ctx, nil, NoAssignment, objectIDs, false)
+	if err != nil {
+		return fmt.Errorf("failed to unassign sla domain: %s", err)
+	}
+
+	return SLAAssignError(results)
+}
+
+// SLAAssignError returns a *graphql.BatchError holding the objects for which
+// the SLA domain assignment failed. Returns nil if the assignment succeeded for
+// all objects.
+func SLAAssignError(results []SLAAssignResult) error {
+	errs := make(map[uuid.UUID]error)
+	for _, result := range results {
+		if !result.Success {
+			errs[result.ObjectID] = errors.New("sla domain assignment failed")
+		}
+	}
+
+	return graphql.NewBatchError(errs)
+}
+
+// AssignSLAForSnappableHierarchies assigns the SLA domain with the specified
+// id to the objects with the specified ids. The SLA domain id should be nil
+// unless assignType is ProtectWithSLAID. When applyToExisting is true, the SLA
+// domain is also applied to the existing snapshots of the objects. Returns the
+// result of the assignment for each object, in the same order as the object
+// ids. No request is made if there are no object ids.
+func (a API) AssignSLAForSnappableHierarchies(ctx context.Context, slaID *uuid.UUID, assignType SLAAssignType, objectIDs []uuid.UUID, applyToExisting bool) ([]SLAAssignResult, error) {
+	a.log.Print(log.Trace)
+
+	if len(objectIDs) == 0 {
+		return nil, nil
+	}
+
+	query := assignSlasForSnappableHierarchiesQuery
+	buf, err := a.GQL.Request(ctx, query, struct {
+		SLAID           *uuid.UUID    `json:"slaId,omitempty"`
+		AssignType      SLAAssignType `json:"assignType"`
+		ObjectIDs       []uuid.UUID   `json:"objectIds"`
+		ApplyToExisting bool          `json:"applyToExistingSnapshots"`
+	}{SLAID: slaID, AssignType: assignType, ObjectIDs: objectIDs, ApplyToExisting: applyToExisting})
+	if err != nil {
+		return nil, graphql.RequestError(query, err)
+	}
+	graphql.LogResponse(a.log, query, buf)
+
+	var payload struct {
+		Data struct {
+			Result []struct {
+				Success bool `json:"success"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(buf, &payload); err != nil {
+		return nil, graphql.UnmarshalError(query, err)
+	}
+	if len(payload.Data.Result) != len(objectIDs) {
+		return nil, graphql.ResponseError(query, errors.New("unexpected number of results"))
+	}
+
+	results := make([]SLAAssignResult, 0, len(payload.Data.Result))
+	for i, result := range payload.Data.Result {
+		results = append(results, SLAAssignResult{ObjectID: objectIDs[i], Success: result.Success})
+	}
+
+	return results, nil
+}
+
+// ComplianceStatus represents the SLA compliance status of an object.
+type ComplianceStatus string
+
+const (
+	ComplianceStatusEmpty           ComplianceStatus = "EMPTY"
+	ComplianceStatusInCompliance    ComplianceStatus = "IN_COMPLIANCE"
+	ComplianceStatusNotAvailable    ComplianceStatus = "NOT_AVAILABLE"
+	ComplianceStatusOutOfCompliance ComplianceStatus = "OUT_OF_COMPLIANCE"
+)
+
+// ObjectProtection holds the protection details of an object. Compliance
+// status is empty and LastSnapshot is the zero time for objects which don't
+// take snapshots themselves, e.g., cloud accounts.
+//
+// There is no next snapshot time. Neither the hierarchy object nor the
+// snappable of the object exposes one in the RSC GraphQL API, RSC schedules
+// snapshots internally from the frequencies of the effective SLA domain.
+type ObjectProtection struct {
+	ID                  uuid.UUID
+	Name                string
+	ObjectType          string
+	SLAAssignment       SLAAssignment // Direct, Derived or Unassigned.
+	ConfiguredSLADomain SLADomain
+	EffectiveSLADomain  SLADomain
+	ComplianceStatus    ComplianceStatus
+	LastSnapshot        time.Time
+}
+
+// Protected returns true if the object is protected by an SLA domain.
+func (p ObjectProtection) Protected() bool {
+	return p.EffectiveSLADomain.Protects()
+}
+
+// Inherited returns true if the effective SLA domain of the object is
+// inherited from one of its parents in the object hierarchy.
+func (p ObjectProtection) Inherited() bool {
+	return p.SLAAssignment == Derived
+}
+
+// ObjectProtectionStatus returns the protection details of the object with the
+// specified id. The object can be of any object type in the object hierarchy.
+func (a API) ObjectProtectionStatus(ctx context.Context, fid uuid.UUID) (ObjectProtection, error) {
+	a.log.Print(log.Trace)
+
+	query := objectProtectionStatusQuery
+	buf, err := a.GQL.Request(ctx, query, struct {
+		FID uuid.UUID `json:"fid"`
+		ID  string    `json:"id"`
+	}{FID: fid, ID: fid.String()})
+	if err != nil {
+		return ObjectProtection{}, graphql.RequestError(query, err)
+	}
+	graphql.LogResponse(a.log, query, buf)
+
+	var payload struct {
+		Data struct {
+			Object *struct {
+				ID            uuid.UUID     `json:"id"`
+				Name          string        `json:"name"`
+				ObjectType    string        `json:"objectType"`
+				SLAAssignment SLAAssignment `json:"slaAssignment"`
+				Configured    SLADomain     `json:"configuredSlaDomain"`
+				Effective     SLADomain     `json:"effectiveSlaDomain"`
+			} `json:"object"`
+			Snappables struct {
+				Nodes []struct {
+					ComplianceStatus ComplianceStatus `json:"complianceStatus"`
+					LastSnapshot     *time.Time       `json:"lastSnapshot"`
+				} `json:"nodes"`
+			} `json:"snappables"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(buf, &payload); err != nil {
+		return ObjectProtection{}, graphql.UnmarshalError(query, err)
+	}
+	if payload.Data.Object == nil {
+		return ObjectProtection{}, fmt.Errorf("object %q %w", fid, graphql.ErrNotFound)
+	}
+
+	object := payload.Data.Object
+	protection := ObjectProtection{
+		ID:                  object.ID,
+		Name:                object.Name,
+		ObjectType:          object.ObjectType,
+		SLAAssignment:       object.SLAAssignment,
+		ConfiguredSLADomain: object.Configured,
+		EffectiveSLADomain:  object.Effective,
+	}
+	if nodes := payload.Data.Snappables.Nodes; len(nodes) > 0 {
+		protection.ComplianceStatus = nodes[0].ComplianceStatus
+		if nodes[0].LastSnapshot != nil {
+			protection.LastSnapshot = *nodes[0].LastSnapshot
+		}
+	}
+
+	return protection, nil
+}
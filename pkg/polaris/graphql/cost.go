@@ -0,0 +1,69 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package graphql
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+// responseExtensions holds the extensions of a GraphQL response.
+type responseExtensions map[string]json.RawMessage
+
+// QueryCost holds the cost of a query/mutation as reported by RSC in the
+// extensions of the response. Query is the name of the query/mutation and Cost
+// is the cost or complexity as is, since its format isn't documented, e.g., a
+// number or an object. Updated is the time the cost was reported.
+type QueryCost struct {
+	Query   string
+	Cost    json.RawMessage
+	Updated time.Time
+}
+
+// LastQueryCost returns the query cost reported by RSC in the most recent
+// response holding a cost. False is returned if no response has held a cost
+// yet. RSC doesn't report the cost of all queries/mutations, so the cost
+// returned can belong to an earlier query/mutation than the last one made.
+func (c *Client) LastQueryCost() (QueryCost, bool) {
+	cost := c.queryCost.Load()
+	if cost == nil {
+		return QueryCost{}, false
+	}
+
+	return *cost, true
+}
+
+// recordQueryCost records and logs the cost, or complexity, found in the
+// extensions of a response. Responses without a cost are ignored.
+func (c *Client) recordQueryCost(query string, extensions responseExtensions) {
+	cost, ok := extensions["cost"]
+	if !ok {
+		if cost, ok = extensions["complexity"]; !ok {
+			return
+		}
+	}
+
+	name := QueryName(query)
+	c.log.Printf(log.Debug, "%s cost: %s", name, string(cost))
+	c.queryCost.Store(&QueryCost{Query: name, Cost: cost, Updated: time.Now()})
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+func TestLastQueryCost(t *testing.T) {
+	client, lis := NewTestClient("john", "doe", log.DiscardLogger{})
+
+	// Respond with the extensions given by the request, if any.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Query().Get("extensions") {
+		case "cost":
+			w.Write([]byte(`{"data": {"result": {"name": "John Doe"}}, "extensions": {"cost": 42}}`))
+		case "complexity":
+			w.Write([]byte(`{"data": {"result": {"name": "John Doe"}}, "extensions": {"complexity": {"score": 7}}}`))
+		default:
+			w.Write([]byte(`{"data": {"result": {"name": "John Doe"}}}`))
+		}
+	})
+	defer srv.Shutdown(context.Background())
+
+	// No cost reported.
+	gqlURL := client.gqlURL
+	if _, err := client.Request(context.Background(), "query SdkGolangMe { result: me { name } }", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := client.LastQueryCost(); ok {
+		t.Fatal("expected no query cost")
+	}
+
+	client.gqlURL = gqlURL + "?extensions=cost"
+	if _, err := client.Request(context.Background(), "query SdkGolangMe { result: me { name } }", nil); err != nil {
+		t.Fatal(err)
+	}
+	cost, ok := client.LastQueryCost()
+	if !ok {
+		t.Fatal("expected query cost")
+	}
+	if cost.Query != "me" || string(cost.Cost) != "42" || cost.Updated.IsZero() {
+		t.Errorf("invalid query cost: %+v", cost)
+	}
+
+	// A response without a cost should leave the cost unchanged.
+	client.gqlURL = gqlURL
+	if _, err := client.Request(context.Background(), "query SdkGolangYou { result: me { name } }", nil); err != nil {
+		t.Fatal(err)
+	}
+	if cost, ok := client.LastQueryCost(); !ok || cost.Query != "me" {
+		t.Errorf("invalid query cost: %+v", cost)
+	}
+
+	// Complexity reported by a streamed response.
+	client.gqlURL = gqlURL + "?extensions=complexity"
+	var out struct {
+		Result struct {
+			Name string `json:"name"`
+		} `json:"result"`
+	}
+	if err := client.RequestDecode(context.Background(), "query SdkGolangYou { result: me { name } }", nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if cost, ok := client.LastQueryCost(); !ok || cost.Query != "you" || string(cost.Cost) != `{"score": 7}` {
+		t.Errorf("invalid query cost: %+v", cost)
+	}
+	if out.Result.Name != "John Doe" {
+		t.Errorf("invalid result: %q", out.Result.Name)
+	}
+}
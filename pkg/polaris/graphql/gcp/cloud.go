@@ -46,8 +46,8 @@ type CloudAccount struct {
 // Feature represents an RSC Cloud Account feature for GCP, e.g. Cloud Native
 // Protection.
 type Feature struct {
-	Feature string      `json:"feature"`
-	Status  core.Status `json:"status"`
+	Feature string             `json:"feature"`
+	Status  core.FeatureStatus `json:"status"`
 }
 
 // CloudAccountWithFeature hold details about a cloud account and the features
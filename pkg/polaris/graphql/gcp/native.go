@@ -28,6 +28,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
 )
@@ -149,3 +150,171 @@ func (a API) NativeDisableProject(ctx context.Context, id uuid.UUID, deleteSnaps
 	}
 	return payload.Data.Query.JobID, nil
 }
+
+// NativeGCEInstance represents a GCP GCE instance in RSC. NewestSnapshot is
+// nil if the instance has no snapshots.
+type NativeGCEInstance struct {
+	ID             uuid.UUID          `json:"id"`
+	NativeID       string             `json:"nativeId"`
+	Name           string             `json:"nativeName"`
+	MachineType    string             `json:"machineType"`
+	Region         string             `json:"region"`
+	Zone           string             `json:"zone"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeGCEInstances returns the GCE instances, which aren't relics, for the
+// native project with the specified RSC native project id.
+func (a API) NativeGCEInstances(ctx context.Context, projectID uuid.UUID) ([]NativeGCEInstance, error) {
+	a.log.Print(log.Trace)
+
+	query := gcpNativeGceInstancesQuery
+	var instances []NativeGCEInstance
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeGCEInstance `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After     string    `json:"after,omitempty"`
+			ProjectID uuid.UUID `json:"projectId"`
+		}{After: cursor, ProjectID: projectID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, instance := range payload.Result.Edges {
+			instances = append(instances, instance.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return instances, nil
+}
+
+// NativeDisk represents a GCP persistent disk in RSC. NewestSnapshot is nil if
+// the disk has no snapshots.
+type NativeDisk struct {
+	ID             uuid.UUID          `json:"id"`
+	NativeID       string             `json:"diskId"`
+	Name           string             `json:"diskName"`
+	DiskType       string             `json:"diskType"`
+	SizeInGiB      int                `json:"sizeInGiBs"`
+	Region         string             `json:"region"`
+	Zone           string             `json:"zone"`
+	Assignment     core.SLAAssignment `json:"slaAssignment"`
+	Configured     core.SLADomain     `json:"configuredSlaDomain"`
+	Effective      core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeDisks returns the persistent disks, which aren't relics, for the native
+// project with the specified RSC native project id.
+func (a API) NativeDisks(ctx context.Context, projectID uuid.UUID) ([]NativeDisk, error) {
+	a.log.Print(log.Trace)
+
+	query := gcpNativeDisksQuery
+	var disks []NativeDisk
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeDisk `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After     string    `json:"after,omitempty"`
+			ProjectID uuid.UUID `json:"projectId"`
+		}{After: cursor, ProjectID: projectID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, disk := range payload.Result.Edges {
+			disks = append(disks, disk.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return disks, nil
+}
+
+// NativeCloudSQLInstance represents a GCP Cloud SQL instance in RSC.
+// NewestSnapshot is nil if the instance has no snapshots.
+type NativeCloudSQLInstance struct {
+	ID              uuid.UUID          `json:"id"`
+	NativeID        string             `json:"nativeId"`
+	Name            string             `json:"name"`
+	DatabaseVersion string             `json:"databaseVersion"`
+	Region          string             `json:"region"`
+	Assignment      core.SLAAssignment `json:"slaAssignment"`
+	Configured      core.SLADomain     `json:"configuredSlaDomain"`
+	Effective       core.SLADomain     `json:"effectiveSlaDomain"`
+	NewestSnapshot  *core.Snapshot     `json:"newestSnapshot"`
+}
+
+// NativeCloudSQLInstances returns the Cloud SQL instances, which aren't relics,
+// for the native project with the specified RSC native project id.
+func (a API) NativeCloudSQLInstances(ctx context.Context, projectID uuid.UUID) ([]NativeCloudSQLInstance, error) {
+	a.log.Print(log.Trace)
+
+	query := gcpCloudSqlInstancesQuery
+	var instances []NativeCloudSQLInstance
+	var cursor string
+	for {
+		var payload struct {
+			Result struct {
+				Count int `json:"count"`
+				Edges []struct {
+					Node NativeCloudSQLInstance `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"result"`
+		}
+		err := a.GQL.RequestDecode(ctx, query, struct {
+			After     string    `json:"after,omitempty"`
+			ProjectID uuid.UUID `json:"projectId"`
+		}{After: cursor, ProjectID: projectID}, &payload)
+		if err != nil {
+			return nil, graphql.RequestError(query, err)
+		}
+		for _, instance := range payload.Result.Edges {
+			instances = append(instances, instance.Node)
+		}
+
+		if !payload.Result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = payload.Result.PageInfo.EndCursor
+	}
+
+	return instances, nil
+}
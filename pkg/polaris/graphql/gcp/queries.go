@@ -77,6 +77,46 @@ var gcpCloudAccountDeleteProjectsQuery = `mutation SdkGolangGcpCloudAccountDelet
     }
 }`
 
+// gcpCloudSqlInstances GraphQL query
+var gcpCloudSqlInstancesQuery = `query SdkGolangGcpCloudSqlInstances($after: String, $projectId: String!) {
+    result: gcpCloudSqlInstances(after: $after, gcpCloudSqlInstancesFilters: {
+        projectFilter: {
+            projectIds: [$projectId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                nativeId
+                name
+                databaseVersion
+                region
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
 // gcpGetDefaultCredentialsServiceAccount GraphQL query
 var gcpGetDefaultCredentialsServiceAccountQuery = `query SdkGolangGcpGetDefaultCredentialsServiceAccount {
     gcpGetDefaultCredentialsServiceAccount
@@ -93,6 +133,89 @@ var gcpNativeDisableProjectQuery = `mutation SdkGolangGcpNativeDisableProject($p
   }
 }`
 
+// gcpNativeDisks GraphQL query
+var gcpNativeDisksQuery = `query SdkGolangGcpNativeDisks($after: String, $projectId: String!) {
+    result: gcpNativeDisks(after: $after, diskFilters: {
+        projectFilter: {
+            projectIds: [$projectId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                diskId
+                diskName
+                diskType
+                sizeInGiBs
+                region
+                zone
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// gcpNativeGceInstances GraphQL query
+var gcpNativeGceInstancesQuery = `query SdkGolangGcpNativeGceInstances($after: String, $projectId: String!) {
+    result: gcpNativeGceInstances(after: $after, gceInstanceFilters: {
+        projectFilter: {
+            projectIds: [$projectId]
+        }
+        relicFilter: {
+            relic: false
+        }
+    }) {
+        count
+        edges {
+            node {
+                id
+                nativeId
+                nativeName
+                machineType
+                region
+                zone
+                slaAssignment
+                configuredSlaDomain {
+                    id
+                    name
+                }
+                effectiveSlaDomain {
+                    id
+                    name
+                }
+                newestSnapshot {
+                    id
+                    date
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
 // gcpNativeProject GraphQL query
 var gcpNativeProjectQuery = `query SdkGolangGcpNativeProject($fid: UUID!) {
     gcpNativeProject(fid: $fid) {
@@ -43,6 +43,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	internalerrors "github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/errors"
@@ -57,6 +58,18 @@ type Client struct {
 	gqlURL  string
 	client  *http.Client
 	log     log.Logger
+
+	// defaultTimeout holds the default request timeout as a time.Duration.
+	defaultTimeout atomic.Int64
+
+	// rateLimit holds the rate limit state last reported by RSC.
+	rateLimit atomic.Pointer[RateLimitState]
+
+	// queryCost holds the query cost last reported by RSC.
+	queryCost atomic.Pointer[QueryCost]
+
+	// compression is true if large request bodies should be gzip compressed.
+	compression atomic.Bool
 }
 
 // NewClient returns a new Client for the specified API URL.
@@ -67,17 +80,34 @@ func NewClient(apiURL string, tokenSource token.Source) *Client {
 // NewClientWithLogger returns a new Client for the specified API URL, logging
 // to the given logger.
 func NewClientWithLogger(apiURL string, tokenSource token.Source, logger log.Logger) *Client {
+	return NewClientWithHTTPClient(apiURL, tokenSource, http.DefaultClient, logger)
+}
+
+// NewClientWithHTTPClient returns a new Client for the specified API URL,
+// logging to the given logger. Requests are sent using a copy of the given
+// HTTP client, with the transport of the HTTP client wrapped to authenticate
+// the requests. If the transport of the HTTP client is nil,
+// http.DefaultTransport is used.
+//
+// Retries of temporary GraphQL errors are made by the Client on top of the
+// HTTP client, so the timeout of the HTTP client applies to each attempt and
+// any retries made by the transport of the HTTP client are made within each
+// attempt.
+func NewClientWithHTTPClient(apiURL string, tokenSource token.Source, httpClient *http.Client, logger log.Logger) *Client {
 	logger.Printf(log.Info, "Polaris API URL: %s", apiURL)
 
-	client := &Client{
-		gqlURL: apiURL + "/graphql",
-		client: &http.Client{
-			Transport: token.NewRoundTripper(http.DefaultTransport, tokenSource),
-		},
-		log: logger,
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
 	}
+	client := *httpClient
+	client.Transport = token.NewRoundTripper(transport, tokenSource)
 
-	return client
+	return &Client{
+		gqlURL: apiURL + "/graphql",
+		client: &client,
+		log:    logger,
+	}
 }
 
 // Deprecated: use NewClientWithLogger.
@@ -142,6 +172,32 @@ func (c *Client) SetLogger(logger log.Logger) {
 	c.log = logger
 }
 
+// CloseIdleConnections closes any idle connections of the underlying HTTP
+// client. Connections in use are not interrupted.
+func (c *Client) CloseIdleConnections() {
+	c.client.CloseIdleConnections()
+}
+
+// SetDefaultRequestTimeout sets the default timeout for requests. When the
+// context passed to a request has no deadline, a deadline is derived from the
+// default timeout. The timeout covers the request including any retries. A
+// timeout of 0, the default, means no timeout.
+func (c *Client) SetDefaultRequestTimeout(timeout time.Duration) {
+	c.defaultTimeout.Store(int64(timeout))
+}
+
+// withDefaultTimeout returns a context with a deadline derived from the
+// default timeout, if the context has no deadline and a default timeout has
+// been set. Otherwise, the context is returned as is.
+func (c *Client) withDefaultTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := time.Duration(c.defaultTimeout.Load())
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
 const requestRetryAttempts = 10
 
 // Request posts the specified GraphQL query/mutation with the given variables
@@ -167,14 +223,57 @@ func (c *Client) Request(ctx context.Context, query string, variables interface{
 func (c *Client) RequestWithoutLogging(ctx context.Context, query string, variables interface{}) ([]byte, error) {
 	c.log.Print(log.Trace)
 
+	ctx, cancel := c.withDefaultTimeout(ctx)
+	defer cancel()
+
+	var buf []byte
+	err := c.retryTemporary(ctx, func() error {
+		var err error
+		buf, err = c.RequestWithoutRetry(ctx, query, variables)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// RequestDecode posts the specified GraphQL query/mutation with the given
+// variables to the Polaris platform and decodes the data part of the response
+// into out, directly from the response body. Unlike Request, the response is
+// never held in memory as JSON text, which reduces the peak memory usage for
+// large responses, e.g., pages of a connection. Since the response isn't
+// buffered, it isn't logged. Certain temporary errors will be retried.
+func (c *Client) RequestDecode(ctx context.Context, query string, variables, out any) error {
+	c.log.Print(log.Trace)
+
+	// Log variables before calling the query/mutation.
+	buf, err := json.Marshal(variables)
+	if err != nil {
+		buf = []byte(fmt.Sprintf("marshaling of variables failed: %s", err))
+	}
+	c.log.Printf(log.Debug, "%s params: %s", QueryName(query), string(buf))
+
+	ctx, cancel := c.withDefaultTimeout(ctx)
+	defer cancel()
+
+	return c.retryTemporary(ctx, func() error {
+		return c.requestDecodeWithoutRetry(ctx, query, variables, out)
+	})
+}
+
+// retryTemporary calls fn until it succeeds, fails with an error which isn't
+// temporary or the retry attempts are exhausted.
+func (c *Client) retryTemporary(ctx context.Context, fn func() error) error {
 	retryAttempt := 0
 	for {
-		buf, err := c.RequestWithoutRetry(ctx, query, variables)
+		err := fn()
 
 		var gqlErr GQLError
 		if errors.As(err, &gqlErr) && gqlErr.isTemporary() {
 			if retryAttempt++; retryAttempt > requestRetryAttempts {
-				return nil, fmt.Errorf("request failed after %d retries: %w", retryAttempt-1, err)
+				return fmt.Errorf("request failed after %d retries: %w", retryAttempt-1, err)
 			}
 
 			c.log.Printf(log.Debug, "Endpoint temporarily unavailable (retry attempt: %d/%d): %s", retryAttempt,
@@ -183,19 +282,52 @@ func (c *Client) RequestWithoutLogging(ctx context.Context, query string, variab
 			case <-time.After(10 * time.Second):
 				continue
 			case <-ctx.Done():
-				return nil, ctx.Err()
+				return ctx.Err()
 			}
 		}
 
-		return buf, err
+		return err
 	}
 }
 
-// RequestWithoutRetry posts the specified GraphQL query/mutation with the given
-// variables to the Polaris platform. Returns the response JSON text as is.
-func (c *Client) RequestWithoutRetry(ctx context.Context, query string, variables interface{}) ([]byte, error) {
+// RawResponse holds the HTTP status, the headers and the body of a response
+// to a GraphQL query/mutation.
+type RawResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// RawRequest posts the specified GraphQL query/mutation with the given
+// variables to the Polaris platform. Returns the HTTP status, the headers and
+// the body of the response as is, without interpreting them. Errors are only
+// returned when the request can't be made or the response body can't be read.
+// The request is not retried. Useful for debugging and for inspecting response
+// headers, e.g., the X-RateLimit-* headers.
+func (c *Client) RawRequest(ctx context.Context, query string, variables any) (*RawResponse, error) {
 	c.log.Print(log.Trace)
 
+	ctx, cancel := c.withDefaultTimeout(ctx)
+	defer cancel()
+
+	res, err := c.do(ctx, query, variables)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	buf, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read graphql response body (status code %d): %v", res.StatusCode, err)
+	}
+
+	return &RawResponse{StatusCode: res.StatusCode, Header: res.Header, Body: buf}, nil
+}
+
+// do posts the specified GraphQL query/mutation with the given variables to
+// the Polaris platform. On success, the caller is responsible for closing the
+// body of the response.
+func (c *Client) do(ctx context.Context, query string, variables any) (*http.Response, error) {
 	// Extract operation name from query to pass in the body of the request for
 	// metrics.
 	operation := operationName(query)
@@ -209,6 +341,10 @@ func (c *Client) RequestWithoutRetry(ctx context.Context, query string, variable
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal graphql request body: %v", err)
 	}
+	buf, compressed, err := c.compressRequestBody(buf)
+	if err != nil {
+		return nil, err
+	}
 
 	// Send the query to the remote API endpoint.
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.gqlURL, bytes.NewReader(buf))
@@ -217,22 +353,109 @@ func (c *Client) RequestWithoutRetry(ctx context.Context, query string, variable
 	}
 	req.Header.Add("Content-Type", "application/json; charset=UTF-8")
 	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Accept-Encoding", "gzip")
+	if compressed {
+		req.Header.Add("Content-Encoding", "gzip")
+	}
+	if traceParent, ok := TraceFromContext(ctx); ok {
+		if ValidTraceParent(traceParent) {
+			req.Header.Set(TraceParentHeader, traceParent)
+		} else {
+			c.log.Printf(log.Warn, "Ignoring invalid traceparent: %q", traceParent)
+		}
+	}
 	res, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to request graphql field: %v", err)
+		return nil, fmt.Errorf("failed to request graphql field: %w", err)
+	}
+	c.updateRateLimitState(res.Header, time.Now())
+	if err := decompressResponse(res); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RequestWithoutRetry posts the specified GraphQL query/mutation with the given
+// variables to the Polaris platform. Returns the response JSON text as is.
+func (c *Client) RequestWithoutRetry(ctx context.Context, query string, variables interface{}) ([]byte, error) {
+	c.log.Print(log.Trace)
+
+	res, err := c.RawRequest(ctx, query, variables)
+	if err != nil {
+		return nil, err
+	}
+	buf, extensions, err := checkResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	c.recordQueryCost(query, extensions)
+
+	return buf, nil
+}
+
+// requestDecodeWithoutRetry posts the specified GraphQL query/mutation with
+// the given variables to the Polaris platform and decodes the data part of the
+// response into out, directly from the response body. Responses which aren't
+// successful GraphQL responses are read in full and handled by checkResponse.
+func (c *Client) requestDecodeWithoutRetry(ctx context.Context, query string, variables, out any) error {
+	c.log.Print(log.Trace)
+
+	res, err := c.do(ctx, query, variables)
+	if err != nil {
+		return err
 	}
 	defer res.Body.Close()
 
+	if res.StatusCode != 200 || !strings.HasPrefix(res.Header.Get("Content-Type"), "application/json") {
+		buf, err := io.ReadAll(res.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read graphql response body (status code %d): %v", res.StatusCode, err)
+		}
+		if _, _, err := checkResponse(&RawResponse{StatusCode: res.StatusCode, Header: res.Header, Body: buf}); err != nil {
+			return err
+		}
+		return fmt.Errorf("graphql response has status code: %d %s", res.StatusCode, http.StatusText(res.StatusCode))
+	}
+
+	// Decode the data part of the response directly into out, while looking
+	// for both known error message formats.
+	var payload struct {
+		internalerrors.JSONError
+		GQLError
+		Extensions responseExtensions `json:"extensions"`
+	}
+	payload.Data = out
+	if err := json.NewDecoder(res.Body).Decode(&payload); err != nil {
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("graphql response has no body (status code %d)", res.StatusCode)
+		}
+		return fmt.Errorf("failed to decode graphql response body (status code %d): %v", res.StatusCode, err)
+	}
+	if payload.JSONError.IsError() {
+		return fmt.Errorf("graphql response body is an error (status code %d): %w", res.StatusCode, payload.JSONError)
+	}
+	if payload.GQLError.isError() {
+		return fmt.Errorf("graphql response body is an error (status code %d): %w", res.StatusCode, payload.GQLError)
+	}
+	c.log.Printf(log.Debug, "%s response decoded", QueryName(query))
+	c.recordQueryCost(query, payload.Extensions)
+
+	return nil
+}
+
+// checkResponse verifies that the raw response is a successful GraphQL
+// response. Returns the response JSON text as is, together with the extensions
+// of the response.
+func checkResponse(res *RawResponse) ([]byte, responseExtensions, error) {
+	buf := res.Body
+
 	// Remote responded without a body. For status code 200, this means we
 	// are missing the GraphQL response. For an error, we have no additional
 	// details.
-	if res.ContentLength == 0 {
-		return nil, fmt.Errorf("graphql response has no body (status code %d)", res.StatusCode)
-	}
-
-	buf, err = io.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read graphql response body (status code %d): %v", res.StatusCode, err)
+	if len(buf) == 0 {
+		return nil, nil, fmt.Errorf("graphql response has no body (status code %d)", res.StatusCode)
 	}
 
 	// Verify that the content type of the body is JSON. For status code 200,
@@ -244,7 +467,7 @@ func (c *Client) RequestWithoutRetry(ctx context.Context, query string, variable
 		if len(snippet) > 512 {
 			snippet = snippet[:512]
 		}
-		return nil, fmt.Errorf("graphql response has Content-Type %s (status code %d): %q",
+		return nil, nil, fmt.Errorf("graphql response has Content-Type %s (status code %d): %q",
 			contentType, res.StatusCode, snippet)
 	}
 
@@ -252,27 +475,61 @@ func (c *Client) RequestWithoutRetry(ctx context.Context, query string, variable
 	// error message formats.
 	var jsonErr internalerrors.JSONError
 	if err := json.Unmarshal(buf, &jsonErr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal graphql response body as an error (status code %d): %v",
+		return nil, nil, fmt.Errorf("failed to unmarshal graphql response body as an error (status code %d): %v",
 			res.StatusCode, err)
 	}
 	if jsonErr.IsError() {
-		return nil, fmt.Errorf("graphql response body is an error (status code %d): %w", res.StatusCode, jsonErr)
+		return nil, nil, fmt.Errorf("graphql response body is an error (status code %d): %w", res.StatusCode, jsonErr)
 	}
 
-	var gqlErr GQLError
+	var gqlErr struct {
+		GQLError
+		Extensions responseExtensions `json:"extensions"`
+	}
 	if err := json.Unmarshal(buf, &gqlErr); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal graphql response body as an error (status code %d): %v",
+		return nil, nil, fmt.Errorf("failed to unmarshal graphql response body as an error (status code %d): %v",
 			res.StatusCode, err)
 	}
 	if gqlErr.isError() {
-		return nil, fmt.Errorf("graphql response body is an error (status code %d): %w", res.StatusCode, gqlErr)
+		return nil, nil, fmt.Errorf("graphql response body is an error (status code %d): %w", res.StatusCode, gqlErr.GQLError)
 	}
 
 	if res.StatusCode != 200 {
-		return nil, fmt.Errorf("graphql response has status code: %s", res.Status)
+		return nil, nil, fmt.Errorf("graphql response has status code: %d %s", res.StatusCode, http.StatusText(res.StatusCode))
 	}
 
-	return buf, nil
+	return buf, gqlErr.Extensions, nil
+}
+
+// Execute posts the specified GraphQL query/mutation with the given variables
+// to the Polaris platform and unmarshals the data part of the response into
+// out. If out is nil, the data part of the response is discarded. Execute is
+// an escape hatch for running queries/mutations not yet wrapped by the SDK,
+// prefer the functions of the low-level and high-level packages when
+// possible.
+func (c *Client) Execute(ctx context.Context, query string, variables, out any) error {
+	c.log.Print(log.Trace)
+
+	buf, err := c.Request(ctx, query, variables)
+	if err != nil {
+		return RequestError(query, err)
+	}
+	LogResponse(c.log, query, buf)
+
+	var payload struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(buf, &payload); err != nil {
+		return UnmarshalError(query, err)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(payload.Data, out); err != nil {
+		return UnmarshalError(query, err)
+	}
+
+	return nil
 }
 
 // LogResponse logs the response from a GraphQL query/mutation.
@@ -22,13 +22,17 @@ package graphql
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"testing"
 	"text/template"
+	"time"
 
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/token"
 )
 
 func TestRequestUnauthenticated(t *testing.T) {
@@ -123,6 +127,201 @@ func TestRequestWithInternalServerErrorTextBody(t *testing.T) {
 	}
 }
 
+func TestExecute(t *testing.T) {
+	client, lis := NewTestClient("john", "doe", log.DiscardLogger{})
+
+	// Respond with status code 200 and a valid body.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"data": {"result": {"name": "John Doe"}}}`))
+	})
+	defer srv.Shutdown(context.Background())
+
+	var out struct {
+		Result struct {
+			Name string `json:"name"`
+		} `json:"result"`
+	}
+	if err := client.Execute(context.Background(), "query SdkGolangMe { result: me { name } }", nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Result.Name != "John Doe" {
+		t.Errorf("invalid name: %q", out.Result.Name)
+	}
+
+	if err := client.Execute(context.Background(), "query SdkGolangMe { result: me { name } }", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRawRequest(t *testing.T) {
+	client, lis := NewTestClient("john", "doe", log.DiscardLogger{})
+
+	// Respond with status code 429, a rate limit header and an error body.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"code": 429, "message": "too many requests"}`))
+	})
+	defer srv.Shutdown(context.Background())
+
+	res, err := client.RawRequest(context.Background(), "query SdkGolangMe { result: me { name } }", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("invalid status code: %d", res.StatusCode)
+	}
+	if remaining := res.Header.Get("X-RateLimit-Remaining"); remaining != "0" {
+		t.Errorf("invalid rate limit header: %q", remaining)
+	}
+	if string(res.Body) != `{"code": 429, "message": "too many requests"}` {
+		t.Errorf("invalid body: %s", res.Body)
+	}
+
+	if _, err := client.Request(context.Background(), "query SdkGolangMe { result: me { name } }", nil); err == nil {
+		t.Error("expected request to fail")
+	}
+}
+
+func TestRequestDecode(t *testing.T) {
+	client, lis := NewTestClient("john", "doe", log.DiscardLogger{})
+
+	// Respond with a page of data for the first request, a GraphQL error for
+	// the second request and an error body for the third request.
+	requests := 0
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		switch requests {
+		case 1:
+			w.Write([]byte(`{"data": {"result": {"edges": [{"node": {"name": "a"}}, {"node": {"name": "b"}}]}}}`))
+		case 2:
+			w.Write([]byte(`{"data": null, "errors": [{"message": "invalid field"}]}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"code": 500, "message": "internal error"}`))
+		}
+	})
+	defer srv.Shutdown(context.Background())
+
+	query := "query SdkGolangNames { result: names { edges { node { name } } } }"
+	var payload struct {
+		Result struct {
+			Edges []struct {
+				Node struct {
+					Name string `json:"name"`
+				} `json:"node"`
+			} `json:"edges"`
+		} `json:"result"`
+	}
+	if err := client.RequestDecode(context.Background(), query, nil, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if edges := payload.Result.Edges; len(edges) != 2 || edges[0].Node.Name != "a" || edges[1].Node.Name != "b" {
+		t.Errorf("invalid payload: %v", payload)
+	}
+
+	err := client.RequestDecode(context.Background(), query, nil, &payload)
+	var gqlErr GQLError
+	if !errors.As(err, &gqlErr) || gqlErr.Errors[0].Message != "invalid field" {
+		t.Errorf("expected graphql error, got: %v", err)
+	}
+
+	err = client.RequestDecode(context.Background(), query, nil, &payload)
+	if err == nil || !strings.Contains(err.Error(), "internal error") {
+		t.Errorf("expected error body, got: %v", err)
+	}
+}
+
+func TestRequestWithTrace(t *testing.T) {
+	client, lis := NewTestClient("john", "doe", log.DiscardLogger{})
+
+	// Respond with the traceparent header received.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"data": {"result": %q}}`, req.Header.Get(TraceParentHeader))
+	})
+	defer srv.Shutdown(context.Background())
+
+	var out struct {
+		Result string `json:"result"`
+	}
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ctx := ContextWithTrace(context.Background(), traceParent)
+	if err := client.Execute(ctx, "query SdkGolangMe { result: me { name } }", nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Result != traceParent {
+		t.Errorf("invalid traceparent: %q", out.Result)
+	}
+
+	ctx = ContextWithTrace(context.Background(), "invalid")
+	if err := client.Execute(ctx, "query SdkGolangMe { result: me { name } }", nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Result != "" {
+		t.Errorf("invalid traceparent: %q", out.Result)
+	}
+}
+
+type countingTransport struct {
+	next  http.RoundTripper
+	count int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count++
+	return t.next.RoundTrip(req)
+}
+
+func TestNewClientWithHTTPClient(t *testing.T) {
+	testClient, lis := testnet.NewPipeNet()
+	transport := &countingTransport{next: testClient.Transport}
+	httpClient := &http.Client{Transport: transport}
+	tokenSource := token.NewUserSourceWithLogger(httpClient, "http://test/api/session", "john", "doe", log.DiscardLogger{})
+	client := NewClientWithHTTPClient("http://test/api", tokenSource, httpClient, log.DiscardLogger{})
+
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"data": {"result": {"name": "John Doe"}}}`))
+	})
+	defer srv.Shutdown(context.Background())
+
+	if err := client.Execute(context.Background(), "query SdkGolangMe { result: me { name } }", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// One request for the token and one for the query.
+	if transport.count != 2 {
+		t.Errorf("invalid number of requests: %d", transport.count)
+	}
+
+	// The HTTP client passed in should not be modified.
+	if httpClient.Transport != transport {
+		t.Error("the HTTP client was modified")
+	}
+}
+
+func TestDefaultRequestTimeout(t *testing.T) {
+	client, lis := NewTestClient("john", "doe", log.DiscardLogger{})
+	client.SetDefaultRequestTimeout(50 * time.Millisecond)
+
+	// Respond when the test is done.
+	done := make(chan struct{})
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		<-done
+		w.Write([]byte(`{"data": {}}`))
+	})
+	defer srv.Shutdown(context.Background())
+	defer close(done)
+
+	start := time.Now()
+	err := client.Execute(context.Background(), "query SdkGolangMe { result: me { name } }", nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("default timeout not applied: %v", elapsed)
+	}
+}
+
 func TestExtractOperationName(t *testing.T) {
 	tt := []struct {
 		query      string
@@ -32,6 +32,8 @@ import (
 	"path/filepath"
 	"strings"
 	"text/template"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/querygen"
 )
 
 // Template used to output the generated Golang source file.
@@ -67,25 +69,6 @@ var {{ $name }}Query = {{ $value }}
 {{ end }}
 `))
 
-// variableName creates a Golang variable name from the query file name by
-// trimming the file suffix removing underscore characters and capitalizing
-// the character after. The first character is not capitalized to prevent the
-// name from being exported.
-func variableName(fileName string) string {
-	var sb strings.Builder
-
-	name := strings.TrimSuffix(strings.ToLower(fileName), ".graphql")
-	for i, part := range strings.Split(name, "_") {
-		if i == 0 {
-			sb.WriteString(part)
-		} else {
-			sb.WriteString(strings.Title(part))
-		}
-	}
-
-	return sb.String()
-}
-
 func main() {
 	// Second argument is the first argument passed to go:generate, which
 	// should be the package to generate queries for.
@@ -106,8 +89,8 @@ func main() {
 			return err
 		}
 
-		name := variableName(info.Name())
-		query := strings.Replace(strings.TrimSpace(string(buf)), "RubrikPolarisSDKRequest", "SdkGolang"+strings.Title(name), 1)
+		name := querygen.VariableName(info.Name())
+		query := querygen.Query(name, string(buf))
 		queries[name] = "`" + query + "`"
 		return nil
 	})
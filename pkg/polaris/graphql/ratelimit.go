@@ -0,0 +1,87 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package graphql
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Rate limit headers returned by RSC.
+const (
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	RateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+// RateLimitState holds the rate limit state last reported by RSC. Remaining
+// is the number of requests remaining in the current rate limit window and
+// Reset is the time the window resets. Reset is the zero time if RSC didn't
+// report a reset time. Updated is the time the state was last reported.
+type RateLimitState struct {
+	Remaining int
+	Reset     time.Time
+	Updated   time.Time
+}
+
+// RateLimitState returns the rate limit state reported by RSC in the most
+// recent response holding rate limit headers. False is returned if no response
+// has held rate limit headers yet.
+func (c *Client) RateLimitState() (RateLimitState, bool) {
+	state := c.rateLimit.Load()
+	if state == nil {
+		return RateLimitState{}, false
+	}
+
+	return *state, true
+}
+
+// updateRateLimitState updates the rate limit state of the client from the
+// rate limit headers of a response. Responses without a valid remaining
+// header leave the state unchanged.
+func (c *Client) updateRateLimitState(header http.Header, now time.Time) {
+	remaining, err := strconv.Atoi(header.Get(RateLimitRemainingHeader))
+	if err != nil {
+		return
+	}
+
+	state := RateLimitState{Remaining: remaining, Updated: now}
+	state.Reset = parseRateLimitReset(header.Get(RateLimitResetHeader), now)
+	c.rateLimit.Store(&state)
+}
+
+// parseRateLimitReset parses the value of the reset header. The value is
+// either a Unix timestamp or the number of seconds until the rate limit window
+// resets. Returns the zero time if the value is not a valid number.
+func parseRateLimitReset(value string, now time.Time) time.Time {
+	reset, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || reset < 0 {
+		return time.Time{}
+	}
+
+	// Values too large to be a reasonable number of seconds are treated as
+	// Unix timestamps.
+	if reset > 1_000_000_000 {
+		return time.Unix(reset, 0)
+	}
+
+	return now.Add(time.Duration(reset) * time.Second)
+}
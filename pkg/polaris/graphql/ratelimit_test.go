@@ -0,0 +1,91 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+func TestRateLimitState(t *testing.T) {
+	client, lis := NewTestClient("john", "doe", log.DiscardLogger{})
+
+	// Respond with the rate limit headers of the request, if any.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		if remaining := req.URL.Query().Get("remaining"); remaining != "" {
+			w.Header().Set(RateLimitRemainingHeader, remaining)
+			w.Header().Set(RateLimitResetHeader, "30")
+		}
+		w.Write([]byte(`{"data": {"result": {"name": "John Doe"}}}`))
+	})
+	defer srv.Shutdown(context.Background())
+
+	if _, ok := client.RateLimitState(); ok {
+		t.Fatal("expected no rate limit state before any request")
+	}
+
+	gqlURL := client.gqlURL
+	client.gqlURL = gqlURL + "?remaining=42"
+	before := time.Now()
+	if _, err := client.Request(context.Background(), "query SdkGolangMe { result: me { name } }", nil); err != nil {
+		t.Fatal(err)
+	}
+	state, ok := client.RateLimitState()
+	if !ok {
+		t.Fatal("expected rate limit state")
+	}
+	if state.Remaining != 42 {
+		t.Errorf("invalid remaining: %d", state.Remaining)
+	}
+	if state.Reset.Before(before.Add(30*time.Second)) || state.Reset.After(time.Now().Add(30*time.Second)) {
+		t.Errorf("invalid reset: %v", state.Reset)
+	}
+
+	// A response without rate limit headers should keep the last state.
+	client.gqlURL = gqlURL
+	if _, err := client.Request(context.Background(), "query SdkGolangMe { result: me { name } }", nil); err != nil {
+		t.Fatal(err)
+	}
+	if state, ok := client.RateLimitState(); !ok || state.Remaining != 42 {
+		t.Errorf("invalid rate limit state: %v, %t", state, ok)
+	}
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	if reset := parseRateLimitReset("60", now); !reset.Equal(now.Add(time.Minute)) {
+		t.Errorf("invalid reset: %v", reset)
+	}
+	if reset := parseRateLimitReset("1714557600", now); !reset.Equal(time.Unix(1714557600, 0)) {
+		t.Errorf("invalid reset: %v", reset)
+	}
+	if reset := parseRateLimitReset("", now); !reset.IsZero() {
+		t.Errorf("invalid reset: %v", reset)
+	}
+	if reset := parseRateLimitReset("soon", now); !reset.IsZero() {
+		t.Errorf("invalid reset: %v", reset)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package graphql
+
+import (
+	"context"
+	"regexp"
+)
+
+// TraceParentHeader is the W3C Trace Context header used to propagate a trace
+// to RSC.
+const TraceParentHeader = "traceparent"
+
+type traceKey struct{}
+
+// traceParentRegexp matches a version 00 W3C traceparent, e.g.
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01.
+var traceParentRegexp = regexp.MustCompile(`^00-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}$`)
+
+// ContextWithTrace returns a copy of the parent context holding the specified
+// W3C traceparent. GraphQL requests made with the returned context pass the
+// traceparent to RSC in the traceparent header, so that RSC logs can be
+// correlated with the trace of the caller.
+func ContextWithTrace(ctx context.Context, traceParent string) context.Context {
+	return context.WithValue(ctx, traceKey{}, traceParent)
+}
+
+// TraceFromContext returns the W3C traceparent held by the context. False is
+// returned if the context doesn't hold a traceparent.
+func TraceFromContext(ctx context.Context) (string, bool) {
+	traceParent, ok := ctx.Value(traceKey{}).(string)
+	return traceParent, ok && traceParent != ""
+}
+
+// ValidTraceParent returns true if the traceparent is a valid version 00 W3C
+// traceparent.
+func ValidTraceParent(traceParent string) bool {
+	return traceParentRegexp.MatchString(traceParent)
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package polaris
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	internalerrors "github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/errors"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+)
+
+// HealthzTimeout is the maximum amount of time Healthz waits for RSC to
+// respond.
+const HealthzTimeout = 10 * time.Second
+
+var (
+	// ErrAuthentication is returned by Healthz when RSC is reachable but
+	// rejects the credentials of the account.
+	ErrAuthentication = errors.New("authentication failed")
+
+	// ErrUnreachable is returned by Healthz when RSC can't be reached.
+	ErrUnreachable = errors.New("RSC unreachable")
+)
+
+// Healthz performs a lightweight authenticated request to RSC. Returns nil if
+// RSC is reachable and the credentials of the account are valid. Suitable for
+// readiness probes. Authentication failures are returned wrapping
+// ErrAuthentication, connectivity failures are returned wrapping
+// ErrUnreachable.
+func (c *Client) Healthz(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, HealthzTimeout)
+	defer cancel()
+
+	_, err := c.GQL.DeploymentVersion(ctx)
+	switch {
+	case err == nil:
+		return nil
+	case isAuthError(err):
+		return fmt.Errorf("%w: %w", ErrAuthentication, err)
+	case isConnectivityError(err):
+		return fmt.Errorf("%w: %w", ErrUnreachable, err)
+	default:
+		return err
+	}
+}
+
+// isAuthError returns true if the error is an authentication or authorization
+// error returned by RSC. RSC uses both HTTP status codes and gRPC status codes
+// for these errors.
+func isAuthError(err error) bool {
+	isAuthCode := func(code int) bool {
+		switch code {
+		case 7, 16, 401, 403: // PERMISSION_DENIED, UNAUTHENTICATED, Unauthorized, Forbidden
+			return true
+		default:
+			return false
+		}
+	}
+
+	var jsonErr internalerrors.JSONError
+	if errors.As(err, &jsonErr) && isAuthCode(jsonErr.Code) {
+		return true
+	}
+	var gqlErr graphql.GQLError
+	if errors.As(err, &gqlErr) && len(gqlErr.Errors) > 0 && isAuthCode(gqlErr.Errors[0].Extensions.Code) {
+		return true
+	}
+
+	return false
+}
+
+// isConnectivityError returns true if the error is caused by a failure to
+// communicate with RSC, e.g. DNS or network errors and timeouts.
+func isConnectivityError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package polaris
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/token"
+)
+
+func TestHealthz(t *testing.T) {
+	gqlClient, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	client := &Client{GQL: gqlClient}
+
+	status := http.StatusOK
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			w.Write([]byte(`{"data": {"deploymentVersion": "v20240101-1"}}`))
+		} else {
+			w.Write([]byte(`{"code": 16, "uri": "/api/graphql", "message": "JWT validation failed: Missing or invalid credentials"}`))
+		}
+	})
+	defer srv.Shutdown(context.Background())
+
+	if err := client.Healthz(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	status = http.StatusUnauthorized
+	if err := client.Healthz(context.Background()); !errors.Is(err, ErrAuthentication) {
+		t.Fatalf("expected authentication error: %v", err)
+	}
+}
+
+func TestHealthzUnreachable(t *testing.T) {
+	tokenSource := token.NewUserSourceWithLogger(http.DefaultClient, "http://127.0.0.1:1/api/session", "john", "doe", log.DiscardLogger{})
+	client := &Client{GQL: graphql.NewClientWithHTTPClient("http://127.0.0.1:1/api", tokenSource, http.DefaultClient, log.DiscardLogger{})}
+
+	if err := client.Healthz(context.Background()); !errors.Is(err, ErrUnreachable) {
+		t.Fatalf("expected connectivity error: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package polaris
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrCertPinMismatch is returned when none of the certificates presented by
+// RSC matches the pinned certificate fingerprints.
+var ErrCertPinMismatch = errors.New("certificate pin mismatch")
+
+// CertFingerprint returns the fingerprint of the DER encoded certificate, i.e.
+// the hex encoded SHA-256 hash of the certificate.
+func CertFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// PinCertificates pins the certificates accepted by the transport to the
+// specified certificate fingerprints. A fingerprint is the hex encoded SHA-256
+// hash of a DER encoded certificate, colons are allowed as separators. A
+// connection is accepted if any certificate in the certificate chain presented
+// by the server matches one of the fingerprints. The normal certificate
+// verification is still performed. Connections not accepted fail with an
+// error wrapping ErrCertPinMismatch.
+func PinCertificates(transport *http.Transport, fingerprints ...string) error {
+	if len(fingerprints) == 0 {
+		return errors.New("at least one certificate fingerprint must be specified")
+	}
+
+	pins := make(map[string]struct{}, len(fingerprints))
+	for _, fingerprint := range fingerprints {
+		pin := strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+		if b, err := hex.DecodeString(pin); err != nil || len(b) != sha256.Size {
+			return fmt.Errorf("invalid certificate fingerprint: %q", fingerprint)
+		}
+		pins[pin] = struct{}{}
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	} else {
+		transport.TLSClientConfig = transport.TLSClientConfig.Clone()
+	}
+	verify := transport.TLSClientConfig.VerifyConnection
+	transport.TLSClientConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		if verify != nil {
+			if err := verify(state); err != nil {
+				return err
+			}
+		}
+		for _, cert := range state.PeerCertificates {
+			if _, ok := pins[CertFingerprint(cert.Raw)]; ok {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w for %s", ErrCertPinMismatch, state.ServerName)
+	}
+
+	return nil
+}
+
+// WithPinnedCertificates returns a TransportOption pinning the certificates
+// accepted by the transport to the specified certificate fingerprints. See
+// PinCertificates for details.
+func WithPinnedCertificates(fingerprints ...string) TransportOption {
+	return func(transport *http.Transport) error {
+		return PinCertificates(transport, fingerprints...)
+	}
+}
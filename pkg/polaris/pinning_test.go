@@ -0,0 +1,98 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package polaris
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/token"
+)
+
+func TestPinCertificates(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "token", "data": {}}`))
+	}))
+	defer srv.Close()
+
+	// The server certificate is pinned using colons as separators.
+	fingerprint := CertFingerprint(srv.Certificate().Raw)
+	var parts []string
+	for i := 0; i < len(fingerprint); i += 2 {
+		parts = append(parts, fingerprint[i:i+2])
+	}
+	transport := srv.Client().Transport.(*http.Transport).Clone()
+	if err := PinCertificates(transport, strings.ToUpper(strings.Join(parts, ":"))); err != nil {
+		t.Fatal(err)
+	}
+	res, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	// Another certificate is pinned.
+	transport = srv.Client().Transport.(*http.Transport).Clone()
+	if err := PinCertificates(transport, CertFingerprint([]byte("other"))); err != nil {
+		t.Fatal(err)
+	}
+	httpClient := &http.Client{Transport: transport}
+	if _, err := httpClient.Get(srv.URL); !errors.Is(err, ErrCertPinMismatch) {
+		t.Fatalf("expected certificate pin mismatch: %v", err)
+	}
+
+	// The pin mismatch error should be preserved through the GraphQL client.
+	tokenSource := token.NewUserSourceWithLogger(httpClient, srv.URL+"/api/session", "john", "doe", log.DiscardLogger{})
+	client := graphql.NewClientWithHTTPClient(srv.URL+"/api", tokenSource, httpClient, log.DiscardLogger{})
+	if err := client.Execute(context.Background(), "query SdkGolangMe { result: me { name } }", nil, nil); !errors.Is(err, ErrCertPinMismatch) {
+		t.Fatalf("expected certificate pin mismatch: %v", err)
+	}
+}
+
+func TestPinCertificatesInvalidFingerprint(t *testing.T) {
+	if err := PinCertificates(&http.Transport{}); err == nil {
+		t.Error("missing fingerprints should fail")
+	}
+	if err := PinCertificates(&http.Transport{}, "abc"); err == nil {
+		t.Error("invalid fingerprint should fail")
+	}
+}
+
+func TestWithPinnedCertificates(t *testing.T) {
+	httpClient, err := NewHTTPClient(WithPinnedCertificates(CertFingerprint([]byte("cert"))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := httpClient.Transport.(*http.Transport)
+	if transport.TLSClientConfig == nil || transport.TLSClientConfig.VerifyConnection == nil {
+		t.Error("certificates should be pinned")
+	}
+	if _, err := NewHTTPClient(WithPinnedCertificates("abc")); err == nil {
+		t.Error("invalid fingerprint should fail")
+	}
+}
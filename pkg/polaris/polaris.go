@@ -29,6 +29,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
@@ -88,6 +89,30 @@ func NewClient(account Account) (*Client, error) {
 // false, given that the account specified allows environment variable
 // overrides.
 func NewClientWithLogger(account Account, logger log.Logger) (*Client, error) {
+	return NewClientWithHTTPClient(account, logger, http.DefaultClient)
+}
+
+// NewClientWithHTTPClient returns a new Client for the specified Account,
+// sending requests, including the requests for authentication tokens, using
+// the given HTTP client. This makes it possible to use a custom transport, e.g.
+// for mTLS proxies or custom TLS root certificates. Authentication is layered
+// on top of the transport of the HTTP client. Note that a custom transport
+// only honors the standard proxy environment variables if its Proxy field is
+// set, see SetProxy.
+//
+// Retries of temporary GraphQL errors are made on top of the HTTP client, so
+// the timeout of the HTTP client applies to each attempt and any retries made
+// by the transport of the HTTP client are made within each attempt.
+//
+// The client will cache authentication tokens by default, this behavior can be
+// overridden by setting the environment variable RUBRIK_POLARIS_TOKEN_CACHE to
+// false, given that the account specified allows environment variable
+// overrides.
+func NewClientWithHTTPClient(account Account, logger log.Logger, httpClient *http.Client) (*Client, error) {
+	if httpClient == nil {
+		return nil, errors.New("failed to create client: invalid HTTP client: nil")
+	}
+
 	cacheToken := true
 	if account.allowEnvOverride() {
 		if tcUse := os.Getenv("RUBRIK_POLARIS_TOKEN_CACHE"); tcUse != "" {
@@ -101,10 +126,10 @@ func NewClientWithLogger(account Account, logger log.Logger) (*Client, error) {
 	switch account := account.(type) {
 	case *UserAccount:
 		tokenSource = token.NewUserSourceWithLogger(
-			http.DefaultClient, account.TokenURL(), account.Username, account.Password, logger)
+			httpClient, account.TokenURL(), account.Username, account.Password, logger)
 	case *ServiceAccount:
 		tokenSource = token.NewServiceAccountSourceWithLogger(
-			http.DefaultClient, account.TokenURL(), account.ClientID, account.ClientSecret, logger)
+			httpClient, account.TokenURL(), account.ClientID, account.ClientSecret, logger)
 	default:
 		return nil, errors.New("failed to create client: invalid account type")
 	}
@@ -120,7 +145,7 @@ func NewClientWithLogger(account Account, logger log.Logger) (*Client, error) {
 
 	return &Client{
 		Account: account,
-		GQL:     graphql.NewClientWithLogger(account.APIURL(), tokenSource, logger),
+		GQL:     graphql.NewClientWithHTTPClient(account.APIURL(), tokenSource, httpClient, logger),
 	}, nil
 }
 
@@ -129,6 +154,28 @@ func (c *Client) SetLogger(logger log.Logger) {
 	c.GQL.SetLogger(logger)
 }
 
+// Close releases the resources held by the client by closing the idle
+// connections of the HTTP client. The client holds no background goroutines,
+// access tokens are refreshed on demand when a request is made. Note that the
+// idle connections are closed on the transport of the HTTP client, which may
+// be shared with other HTTP clients, e.g., http.DefaultTransport. The client
+// shouldn't be used after it has been closed.
+func (c *Client) Close() error {
+	c.GQL.CloseIdleConnections()
+	return nil
+}
+
+// SetDefaultRequestTimeout sets the default timeout for each GraphQL request
+// made to RSC. When the context passed to a request has no deadline, a deadline
+// is derived from the default timeout. Note that the timeout is not a deadline
+// for a high-level call, e.g., adding an account makes several requests and
+// each request gets its own deadline. To limit the duration of a high-level
+// call, pass a context with a deadline. A timeout of 0, the default, means no
+// timeout.
+func (c *Client) SetDefaultRequestTimeout(timeout time.Duration) {
+	c.GQL.SetDefaultRequestTimeout(timeout)
+}
+
 // SetLogLevelFromEnv sets the log level of the logger to the log level
 // specified in the RUBRIK_POLARIS_LOGLEVEL environment variable.
 func SetLogLevelFromEnv(logger log.Logger) error {
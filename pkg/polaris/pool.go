@@ -0,0 +1,69 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package polaris
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Connection pool defaults, the same as for http.DefaultTransport. Tools
+// making many concurrent calls, e.g., onboarding hundreds of accounts, should
+// set the max idle connections per host to the number of concurrent calls, so
+// connections are reused instead of being closed after each call.
+const (
+	// DefaultMaxIdleConnsPerHost is the default maximum number of idle
+	// connections kept per host.
+	DefaultMaxIdleConnsPerHost = http.DefaultMaxIdleConnsPerHost
+
+	// DefaultMaxConnsPerHost is the default maximum number of connections
+	// per host. Zero means no limit.
+	DefaultMaxConnsPerHost = 0
+)
+
+// SetConnectionPool sets the maximum number of idle connections per host and
+// the maximum number of connections per host of the transport. A
+// maxConnsPerHost of zero means no limit. If needed, the maximum number of
+// idle connections across all hosts is raised to maxIdleConnsPerHost.
+func SetConnectionPool(transport *http.Transport, maxIdleConnsPerHost, maxConnsPerHost int) error {
+	if maxIdleConnsPerHost < 0 {
+		return fmt.Errorf("invalid max idle connections per host: %d", maxIdleConnsPerHost)
+	}
+	if maxConnsPerHost < 0 {
+		return fmt.Errorf("invalid max connections per host: %d", maxConnsPerHost)
+	}
+
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.MaxConnsPerHost = maxConnsPerHost
+	if transport.MaxIdleConns != 0 && transport.MaxIdleConns < maxIdleConnsPerHost {
+		transport.MaxIdleConns = maxIdleConnsPerHost
+	}
+
+	return nil
+}
+
+// WithConnectionPool returns a TransportOption setting the connection pool
+// limits of the transport. See SetConnectionPool for details.
+func WithConnectionPool(maxIdleConnsPerHost, maxConnsPerHost int) TransportOption {
+	return func(transport *http.Transport) error {
+		return SetConnectionPool(transport, maxIdleConnsPerHost, maxConnsPerHost)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package polaris
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSetConnectionPool(t *testing.T) {
+	transport := &http.Transport{MaxIdleConns: 10}
+	if err := SetConnectionPool(transport, 50, 100); err != nil {
+		t.Fatal(err)
+	}
+	if transport.MaxIdleConnsPerHost != 50 || transport.MaxConnsPerHost != 100 {
+		t.Errorf("invalid connection pool: %d, %d", transport.MaxIdleConnsPerHost, transport.MaxConnsPerHost)
+	}
+	if transport.MaxIdleConns != 50 {
+		t.Errorf("invalid max idle connections: %d", transport.MaxIdleConns)
+	}
+
+	// Zero max idle connections means no limit and should be left as is.
+	transport = &http.Transport{}
+	if err := SetConnectionPool(transport, 50, DefaultMaxConnsPerHost); err != nil {
+		t.Fatal(err)
+	}
+	if transport.MaxIdleConns != 0 {
+		t.Errorf("invalid max idle connections: %d", transport.MaxIdleConns)
+	}
+
+	if err := SetConnectionPool(transport, -1, 0); err == nil {
+		t.Error("negative max idle connections per host should fail")
+	}
+	if err := SetConnectionPool(transport, 0, -1); err == nil {
+		t.Error("negative max connections per host should fail")
+	}
+}
+
+func TestWithConnectionPool(t *testing.T) {
+	// The transport options can be combined.
+	httpClient, err := NewHTTPClient(
+		WithProxy("http://proxy.example.com:3128"),
+		WithPinnedCertificates(CertFingerprint([]byte("cert"))),
+		WithConnectionPool(50, 100),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := httpClient.Transport.(*http.Transport)
+	if transport.MaxIdleConnsPerHost != 50 || transport.MaxConnsPerHost != 100 {
+		t.Errorf("invalid connection pool: %d, %d", transport.MaxIdleConnsPerHost, transport.MaxConnsPerHost)
+	}
+	if transport.TLSClientConfig == nil || transport.TLSClientConfig.VerifyConnection == nil {
+		t.Error("certificates should be pinned")
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://rsc.example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u, err := transport.Proxy(req); err != nil || u.String() != "http://proxy.example.com:3128" {
+		t.Errorf("invalid proxy: %v, %v", u, err)
+	}
+
+	if _, err := NewHTTPClient(WithConnectionPool(-1, 0)); err == nil {
+		t.Error("negative max idle connections per host should fail")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package polaris
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SetProxy sets the proxy of the transport to the specified proxy URL,
+// overriding the standard proxy environment variables. If the proxy URL is
+// empty, the transport uses the proxy given by the HTTPS_PROXY, HTTP_PROXY and
+// NO_PROXY environment variables, the same way http.DefaultTransport does.
+func SetProxy(transport *http.Transport, proxyURL string) error {
+	if proxyURL == "" {
+		transport.Proxy = http.ProxyFromEnvironment
+		return nil
+	}
+
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return fmt.Errorf("invalid proxy URL: %s", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid proxy URL %q: scheme and host are required", proxyURL)
+	}
+	transport.Proxy = http.ProxyURL(u)
+
+	return nil
+}
+
+// WithProxy returns a TransportOption setting the proxy of the transport to the
+// specified proxy URL. See SetProxy for details.
+func WithProxy(proxyURL string) TransportOption {
+	return func(transport *http.Transport) error {
+		return SetProxy(transport, proxyURL)
+	}
+}
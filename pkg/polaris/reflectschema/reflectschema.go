@@ -0,0 +1,240 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package reflectschema generates JSON Schema descriptions of Go types using
+// reflection. The schema is driven by the json struct tags of the types, the
+// same tags used when the SDK marshals requests to RSC. This makes it possible
+// for tool builders, e.g. Terraform provider authors, to keep their schemas in
+// sync with the SDK.
+package reflectschema
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// SchemaVersion is the JSON Schema version of the generated schemas.
+const SchemaVersion = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema represents a JSON Schema. Only the subset of JSON Schema needed to
+// describe Go types is supported.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// For returns the JSON Schema for the type of the specified value. Recursive
+// types are not supported.
+func For(value any) (*Schema, error) {
+	if value == nil {
+		return nil, fmt.Errorf("invalid value: nil")
+	}
+
+	return ForType(reflect.TypeOf(value))
+}
+
+// ForType returns the JSON Schema for the specified type. Recursive types are
+// not supported.
+func ForType(typ reflect.Type) (*Schema, error) {
+	schema, err := generate(typ, map[reflect.Type]bool{})
+	if err != nil {
+		return nil, err
+	}
+	schema.Schema = SchemaVersion
+	schema.Title = typ.Name()
+	for typ.Kind() == reflect.Pointer {
+		schema.Title = typ.Elem().Name()
+		typ = typ.Elem()
+	}
+
+	return schema, nil
+}
+
+// JSON returns the JSON Schema for the type of the specified value as indented
+// JSON.
+func JSON(value any) ([]byte, error) {
+	schema, err := For(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// generate returns the JSON Schema for the specified type. The visiting map
+// holds the struct types currently being generated, it's used to detect
+// recursive types.
+func generate(typ reflect.Type, visiting map[reflect.Type]bool) (*Schema, error) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	// Types with custom marshaling are checked before the kind of the type,
+	// since the kind doesn't describe how the type is marshaled.
+	switch {
+	case typ == timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	case typ.Implements(jsonMarshalerType) || reflect.PointerTo(typ).Implements(jsonMarshalerType):
+		return &Schema{}, nil
+	case typ.Implements(textMarshalerType) || reflect.PointerTo(typ).Implements(textMarshalerType):
+		if typ.PkgPath() == "github.com/google/uuid" && typ.Name() == "UUID" {
+			return &Schema{Type: "string", Format: "uuid"}, nil
+		}
+		return &Schema{Type: "string"}, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Slice, reflect.Array:
+		// Byte slices are marshaled as base64 encoded strings.
+		if typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+		items, err := generate(typ.Elem(), visiting)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type: %s", typ.Key())
+		}
+		values, err := generate(typ.Elem(), visiting)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		if visiting[typ] {
+			return nil, fmt.Errorf("unsupported recursive type: %s", typ)
+		}
+		visiting[typ] = true
+		defer delete(visiting, typ)
+
+		schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+		if err := addFields(schema, typ, visiting); err != nil {
+			return nil, err
+		}
+		return schema, nil
+	default:
+		return nil, fmt.Errorf("unsupported type: %s", typ)
+	}
+}
+
+// addFields adds the fields of the struct type to the schema. Fields of
+// embedded structs without a json tag are added as fields of the outer struct,
+// the same way encoding/json marshals them. Fields which aren't omitted when
+// empty are required, and pointer fields which aren't omitted are nullable.
+func addFields(schema *Schema, typ reflect.Type, visiting map[reflect.Type]bool) error {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				if err := addFields(schema, fieldType, visiting); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldSchema, err := generate(field.Type, visiting)
+		if err != nil {
+			return fmt.Errorf("field %s: %s", field.Name, err)
+		}
+
+		// The string option marshals booleans and numbers as JSON strings.
+		if hasOption(opts, "string") {
+			switch fieldSchema.Type {
+			case "boolean", "integer", "number":
+				fieldSchema = &Schema{Type: "string"}
+			}
+		}
+
+		omitted := hasOption(opts, "omitempty") || hasOption(opts, "omitzero")
+		if !omitted {
+			schema.Required = append(schema.Required, name)
+
+			// A nil pointer which isn't omitted is marshaled as null. A schema
+			// without a type already allows null.
+			if field.Type.Kind() == reflect.Pointer && fieldSchema.Type != "" {
+				fieldSchema = &Schema{AnyOf: []*Schema{fieldSchema, {Type: "null"}}}
+			}
+		}
+		schema.Properties[name] = fieldSchema
+	}
+
+	return nil
+}
+
+// hasOption returns true if the comma separated list of json tag options
+// contains the specified option.
+func hasOption(opts, option string) bool {
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == option {
+			return true
+		}
+	}
+
+	return false
+}
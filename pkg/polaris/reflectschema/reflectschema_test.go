@@ -0,0 +1,140 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package reflectschema
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type testBase struct {
+	ID uuid.UUID `json:"id"`
+}
+
+type testInput struct {
+	testBase
+	Name     string            `json:"name"`
+	Count    int               `json:"count,omitempty"`
+	Enabled  *bool             `json:"enabled"`
+	Created  time.Time         `json:"created"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Data     []byte            `json:"data,omitempty"`
+	Size     int64             `json:"size,string"`
+	Ratio    *float64          `json:"ratio,omitempty,string"`
+	Parent   *testBase         `json:"parent"`
+	Note     *string           `json:"note,omitempty"`
+	Internal string            `json:"-"`
+	NoTag    float64
+	private  string
+}
+
+type testRecursive struct {
+	Children []testRecursive `json:"children"`
+}
+
+func TestFor(t *testing.T) {
+	schema, err := For(testInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if schema.Schema != SchemaVersion || schema.Title != "testInput" || schema.Type != "object" {
+		t.Errorf("invalid schema: %+v", schema)
+	}
+
+	expected := map[string]Schema{
+		"id":      {Type: "string", Format: "uuid"},
+		"name":    {Type: "string"},
+		"count":   {Type: "integer"},
+		"enabled": {AnyOf: []*Schema{{Type: "boolean"}, {Type: "null"}}},
+		"created": {Type: "string", Format: "date-time"},
+		"tags":    {Type: "array", Items: &Schema{Type: "string"}},
+		"labels":  {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+		"data":    {Type: "string", Format: "byte"},
+		"size":    {Type: "string"},
+		"ratio":   {Type: "string"},
+		"parent": {AnyOf: []*Schema{{
+			Type:       "object",
+			Properties: map[string]*Schema{"id": {Type: "string", Format: "uuid"}},
+			Required:   []string{"id"},
+		}, {Type: "null"}}},
+		"note":  {Type: "string"},
+		"NoTag": {Type: "number"},
+	}
+	if len(schema.Properties) != len(expected) {
+		t.Errorf("invalid number of properties: %d", len(schema.Properties))
+	}
+	for name, property := range expected {
+		if p, ok := schema.Properties[name]; !ok || !reflect.DeepEqual(*p, property) {
+			t.Errorf("invalid property %q: %+v", name, p)
+		}
+	}
+
+	required := []string{"id", "name", "enabled", "created", "tags", "size", "parent", "NoTag"}
+	if !reflect.DeepEqual(schema.Required, required) {
+		t.Errorf("invalid required properties: %v", schema.Required)
+	}
+}
+
+func TestForPointer(t *testing.T) {
+	schema, err := For(&testBase{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema.Title != "testBase" || schema.Type != "object" {
+		t.Errorf("invalid schema: %+v", schema)
+	}
+}
+
+func TestForRecursive(t *testing.T) {
+	if _, err := For(testRecursive{}); err == nil {
+		t.Error("recursive type should fail")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	buf, err := JSON(testBase{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "testBase",
+  "type": "object",
+  "properties": {
+    "id": {
+      "type": "string",
+      "format": "uuid"
+    }
+  },
+  "required": [
+    "id"
+  ]
+}`
+	if string(buf) != expected {
+		t.Errorf("invalid JSON: %s", buf)
+	}
+}
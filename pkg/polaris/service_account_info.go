@@ -0,0 +1,102 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package polaris
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/access"
+)
+
+// ServiceAccountInfo holds information about the RSC service account a client
+// is authenticated as. The roles hold the permissions, the operations and the
+// objects they apply to, the service account is allowed. There are no scopes,
+// RSC service accounts and their access tokens are only restricted by the
+// roles. LastLogin is nil if the service account has never been used.
+type ServiceAccountInfo struct {
+	Name        string
+	ClientID    string
+	Description string
+	LastLogin   *time.Time
+	Roles       []access.Role
+}
+
+// Operations returns the operations allowed by the roles of the service
+// account, without duplicates.
+func (i ServiceAccountInfo) Operations() []string {
+	var operations []string
+	seen := make(map[string]struct{})
+	for _, role := range i.Roles {
+		for _, permission := range role.ExplicitlyAssignedPermissions {
+			if _, ok := seen[permission.Operation]; !ok {
+				seen[permission.Operation] = struct{}{}
+				operations = append(operations, permission.Operation)
+			}
+		}
+	}
+
+	return operations
+}
+
+// ServiceAccountInfo returns information about the RSC service account the
+// client is authenticated as. The service account is looked up by client ID,
+// the name of the local service account doesn't have to match the name in
+// RSC. Returns an error if the client isn't authenticated using a service
+// account.
+func (c *Client) ServiceAccountInfo(ctx context.Context) (ServiceAccountInfo, error) {
+	account, ok := c.Account.(*ServiceAccount)
+	if !ok {
+		return ServiceAccountInfo{}, errors.New("client is not authenticated using a service account")
+	}
+
+	serviceAccount, err := access.Wrap(c.GQL).ServiceAccountByClientID(ctx, account.ClientID)
+	if err != nil {
+		return ServiceAccountInfo{}, fmt.Errorf("failed to get service account: %w", err)
+	}
+	info := ServiceAccountInfo{
+		Name:        serviceAccount.Name,
+		ClientID:    serviceAccount.ClientID,
+		Description: serviceAccount.Description,
+		LastLogin:   serviceAccount.LastLogin,
+		Roles:       serviceAccount.Roles,
+	}
+
+	// The service account query only returns the id and name of the roles,
+	// look up the roles to get their permissions.
+	if len(info.Roles) > 0 {
+		roleIDs := make([]uuid.UUID, 0, len(info.Roles))
+		for _, role := range info.Roles {
+			roleIDs = append(roleIDs, role.ID)
+		}
+		roles, err := access.Wrap(c.GQL).RolesByIDs(ctx, roleIDs)
+		if err != nil {
+			return ServiceAccountInfo{}, fmt.Errorf("failed to get roles: %w", err)
+		}
+		info.Roles = roles
+	}
+
+	return info, nil
+}
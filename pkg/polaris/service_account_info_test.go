@@ -0,0 +1,100 @@
+// Copyright 2024 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package polaris
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/internal/testnet"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/log"
+)
+
+func TestServiceAccountInfo(t *testing.T) {
+	gqlClient, lis := graphql.NewTestClient("john", "doe", log.DiscardLogger{})
+	client := &Client{Account: &ServiceAccount{Name: "renamed", ClientID: "client|123"}, GQL: gqlClient}
+
+	// Respond to the service accounts query with two service accounts having
+	// the same name and to the roles query with the role permissions. The
+	// service accounts are looked up by client ID, so the query shouldn't be
+	// filtered by the name of the local service account.
+	srv := testnet.ServeJSONWithStaticToken(lis, func(w http.ResponseWriter, req *http.Request) {
+		var payload struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), 500)
+			return
+		}
+
+		switch {
+		case strings.Contains(payload.Query, "serviceAccounts"):
+			if _, ok := payload.Variables["nameFilter"]; ok {
+				http.Error(w, "unexpected name filter", 400)
+				return
+			}
+			w.Write([]byte(`{"data": {"result": {"edges": [
+				{"node": {"clientId": "client|456", "name": "automation", "roles": []}},
+				{"node": {"clientId": "client|123", "name": "automation", "description": "CI", "roles": [
+					{"id": "00000000-0000-0000-0000-000000000001", "name": "Administrator"}
+				]}}
+			], "pageInfo": {"hasNextPage": false}}}}`))
+		case strings.Contains(payload.Query, "getRolesByIds"):
+			w.Write([]byte(`{"data": {"result": [{"id": "00000000-0000-0000-0000-000000000001", "name": "Administrator",
+				"explicitlyAssignedPermissions": [{"operation": "VIEW_CLUSTER"}, {"operation": "MANAGE_CLUSTER"}, {"operation": "VIEW_CLUSTER"}]
+			}]}}`))
+		default:
+			http.Error(w, "unexpected query", 400)
+		}
+	})
+	defer srv.Shutdown(context.Background())
+
+	info, err := client.ServiceAccountInfo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "automation" || info.ClientID != "client|123" || info.Description != "CI" {
+		t.Errorf("invalid service account info: %v", info)
+	}
+	if len(info.Roles) != 1 || info.Roles[0].Name != "Administrator" {
+		t.Errorf("invalid roles: %v", info.Roles)
+	}
+	if operations := info.Operations(); !reflect.DeepEqual(operations, []string{"VIEW_CLUSTER", "MANAGE_CLUSTER"}) {
+		t.Errorf("invalid operations: %v", operations)
+	}
+
+	client.Account = &ServiceAccount{Name: "automation", ClientID: "client|789"}
+	if _, err := client.ServiceAccountInfo(context.Background()); !errors.Is(err, graphql.ErrNotFound) {
+		t.Errorf("expected not found error: %v", err)
+	}
+
+	client.Account = &UserAccount{}
+	if _, err := client.ServiceAccountInfo(context.Background()); err == nil {
+		t.Error("expected user account to fail")
+	}
+}
@@ -108,7 +108,7 @@ func (c *cache) token(ctx context.Context) (token, error) {
 
 	cachedToken, err = c.source.token(ctx)
 	if err != nil {
-		return token{}, fmt.Errorf("failed to fetch new token: %s", err)
+		return token{}, fmt.Errorf("failed to fetch new token: %w", err)
 	}
 
 	if err := writeCache(c.file, cachedToken, c.block); err != nil {
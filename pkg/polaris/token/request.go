@@ -63,7 +63,7 @@ func requestToken(ctx context.Context, client *http.Client, tokenURL string, req
 		if errors.Is(err, context.DeadlineExceeded) {
 			return nil, errRequestTimeout
 		}
-		return nil, fmt.Errorf("failed to request token: %v", err)
+		return nil, fmt.Errorf("failed to request token: %w", err)
 	}
 	defer res.Body.Close()
 	// Remote responded without a body. For status code 200, this means we are
@@ -127,7 +127,7 @@ func RequestWithContext(ctx context.Context, client *http.Client, tokenURL strin
 			return resp, nil
 		}
 		if !errors.Is(err, errRequestTimeout) {
-			return nil, fmt.Errorf("failed to acquire access token: %v", err)
+			return nil, fmt.Errorf("failed to acquire access token: %w", err)
 		}
 	}
 
@@ -71,7 +71,7 @@ func (t *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 		t.token, err = t.src.token(req.Context())
 		if err != nil {
 			t.mutex.Unlock()
-			return nil, fmt.Errorf("failed to refresh access token: %v", err)
+			return nil, fmt.Errorf("failed to refresh access token: %w", err)
 		}
 	}
 	t.token.setAsAuthHeader(authReq)
@@ -82,3 +82,14 @@ func (t *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	closeBody = false
 	return t.next.RoundTrip(authReq)
 }
+
+// CloseIdleConnections closes any idle connections of the decorated
+// RoundTripper, if it supports closing idle connections.
+func (t *RoundTripper) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+	if next, ok := t.next.(closeIdler); ok {
+		next.CloseIdleConnections()
+	}
+}
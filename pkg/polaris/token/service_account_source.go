@@ -72,7 +72,7 @@ func (src *ServiceAccountSource) token(ctx context.Context) (token, error) {
 
 	resp, err := RequestWithContext(ctx, src.client, src.tokenURL, body, src.log)
 	if err != nil {
-		return token{}, fmt.Errorf("failed to acquire service account access token: %v", err)
+		return token{}, fmt.Errorf("failed to acquire service account access token: %w", err)
 	}
 
 	// Try to parse the JSON document as an access token. Verify that the
@@ -188,3 +188,28 @@ func TestTokenSourceWithInternalServerErrorTextBody(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// closeIdleTransport is a RoundTripper recording calls to
+// CloseIdleConnections.
+type closeIdleTransport struct {
+	http.RoundTripper
+	closed bool
+}
+
+func (t *closeIdleTransport) CloseIdleConnections() {
+	t.closed = true
+}
+
+func TestRoundTripperCloseIdleConnections(t *testing.T) {
+	transport := &closeIdleTransport{}
+	client := &http.Client{Transport: NewRoundTripper(transport, nil)}
+	client.CloseIdleConnections()
+	if !transport.closed {
+		t.Error("idle connections of the decorated transport should be closed")
+	}
+
+	// A decorated transport without support for closing idle connections
+	// should be ignored.
+	client = &http.Client{Transport: NewRoundTripper(http.NewFileTransport(http.Dir(".")), nil)}
+	client.CloseIdleConnections()
+}
@@ -79,7 +79,7 @@ func (src *UserSource) token(ctx context.Context) (token, error) {
 
 	resp, err := RequestWithContext(ctx, src.client, src.tokenURL, body, src.log)
 	if err != nil {
-		return token{}, fmt.Errorf("failed to acquire local user access token: %v", err)
+		return token{}, fmt.Errorf("failed to acquire local user access token: %w", err)
 	}
 
 	// Try to parse the JSON document as an access token.